@@ -3,7 +3,11 @@ package events
 
 import (
 	"authz/application"
+	"authz/domain"
 	"authz/domain/contracts"
+	"authz/infrastructure/metrics"
+	"context"
+	"time"
 
 	"github.com/golang/glog"
 )
@@ -12,14 +16,18 @@ import (
 type EventAdapter struct {
 	licenseAppService *application.LicenseAppService
 	bus               contracts.MessageBusRepository
+	deadLetters       contracts.DeadLetterRepository
+	maxAttempts       int
 	done              chan interface{}
 }
 
-// NewEventAdapter constructs a new event adapter object from the given dependencies
-func NewEventAdapter(licenseAppService *application.LicenseAppService, bus contracts.MessageBusRepository) *EventAdapter {
+// NewEventAdapter constructs a new event adapter object from the given dependencies. Events that still fail after maxAttempts deliveries, and messages that cannot be parsed, are stored as dead letters.
+func NewEventAdapter(licenseAppService *application.LicenseAppService, bus contracts.MessageBusRepository, deadLetters contracts.DeadLetterRepository, maxAttempts int) *EventAdapter {
 	return &EventAdapter{
 		licenseAppService: licenseAppService,
 		bus:               bus,
+		deadLetters:       deadLetters,
+		maxAttempts:       maxAttempts,
 		done:              make(chan interface{}),
 	}
 }
@@ -39,6 +47,7 @@ func (e *EventAdapter) Start() error {
 func (e *EventAdapter) run(evts contracts.UserEvents) {
 	ok := true
 	var evt contracts.SubjectAddOrUpdateEvent
+	var msg contracts.UnparseableMessage
 	var err error
 
 	for ok {
@@ -46,9 +55,15 @@ func (e *EventAdapter) run(evts contracts.UserEvents) {
 		case evt, ok = <-evts.SubjectChanges:
 			if ok {
 				glog.Infof("Subject event from UMB connection: %+v", evt)
-				err = e.licenseAppService.HandleSubjectAddOrUpdateEvent(evt)
+				err = e.licenseAppService.HandleSubjectAddOrUpdateEvent(context.Background(), evt)
 				e.sendResult(evt, err)
 			}
+		case msg, ok = <-evts.Unparseable:
+			if ok {
+				glog.Errorf("Unparseable message from UMB connection: %v", msg.Err)
+				metrics.UMBMessages.WithLabelValues("unparseable").Inc()
+				e.addDeadLetter(domain.DeadLetter{Payload: msg.Payload, Reason: msg.Err.Error(), Attempts: 1})
+			}
 		case err, ok = <-evts.Errors:
 			if ok {
 				glog.Errorf("Error from UMB connection: %v", err)
@@ -60,13 +75,19 @@ func (e *EventAdapter) run(evts contracts.UserEvents) {
 
 func (e *EventAdapter) sendResult(evt contracts.SubjectAddOrUpdateEvent, err error) {
 	if err == nil {
+		metrics.UMBMessages.WithLabelValues("success").Inc()
 		err = e.bus.ReportSuccess(evt)
 
 		if err != nil {
 			glog.Errorf("Error reporting success: %v", err)
 		}
+	} else if evt.DeliveryAttempts >= e.maxAttempts {
+		glog.Errorf("Error processing message %+v, giving up after %d attempts: %v", evt, evt.DeliveryAttempts, err)
+		metrics.UMBMessages.WithLabelValues("deadlettered").Inc()
+		e.deadLetter(evt, err)
 	} else {
 		glog.Errorf("Error processing message %+v: %v", evt, err)
+		metrics.UMBMessages.WithLabelValues("failure").Inc()
 		err = e.bus.ReportFailure(evt)
 
 		if err != nil {
@@ -75,6 +96,48 @@ func (e *EventAdapter) sendResult(evt contracts.SubjectAddOrUpdateEvent, err err
 	}
 }
 
+// deadLetter stores the event as a dead letter and then removes it from the bus. If it cannot be stored, the bus delivers it again.
+func (e *EventAdapter) deadLetter(evt contracts.SubjectAddOrUpdateEvent, cause error) {
+	stored := e.addDeadLetter(domain.DeadLetter{
+		SubjectID: domain.SubjectID(evt.SubjectID),
+		OrgID:     evt.OrgID,
+		Active:    evt.Active,
+		Deleted:   evt.Deleted,
+		Payload:   evt.Payload,
+		Reason:    cause.Error(),
+		Attempts:  evt.DeliveryAttempts,
+	})
+
+	var err error
+	if stored {
+		err = e.bus.ReportDeadLettered(evt, cause.Error())
+	} else {
+		err = e.bus.ReportFailure(evt)
+	}
+
+	if err != nil {
+		glog.Errorf("Error reporting failure: %v", err)
+	}
+}
+
+func (e *EventAdapter) addDeadLetter(letter domain.DeadLetter) bool {
+	letter.Time = time.Now().UTC()
+	letter.ID = domain.NewDeadLetterID(letter.Time)
+
+	if err := e.deadLetters.AddDeadLetter(context.Background(), letter); err != nil {
+		glog.Errorf("Error storing dead letter %+v: %v", letter, err)
+		return false
+	}
+
+	glog.Infof("Stored dead letter %s", letter.ID)
+	return true
+}
+
+// IsConnected returns true while the connection to the message bus is established
+func (e *EventAdapter) IsConnected() bool {
+	return e.bus.IsConnected()
+}
+
 // Stop disconnects from the message bus, completes any message processing in progress, and then returns
 func (e *EventAdapter) Stop() {
 	e.bus.Disconnect()
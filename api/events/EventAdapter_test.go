@@ -0,0 +1,186 @@
+package events
+
+import (
+	"authz/application"
+	"authz/domain"
+	"authz/domain/contracts"
+	"authz/infrastructure/repository/memory"
+	"authz/infrastructure/repository/mock"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessedEventIsReportedAsSuccess(t *testing.T) {
+	adapter, bus, repo := newEventAdapter(t, nil)
+
+	bus.deliver(contracts.SubjectAddOrUpdateEvent{SubjectID: "new_user", OrgID: "o1", Active: true, DeliveryAttempts: 1})
+	adapter.Stop()
+
+	assert.Equal(t, []string{"success"}, bus.reports())
+	assertNoDeadLetters(t, repo)
+}
+
+func TestFailedEventIsDeliveredAgainBelowMaxAttempts(t *testing.T) {
+	adapter, bus, repo := newEventAdapter(t, errors.New("store unavailable"))
+
+	bus.deliver(contracts.SubjectAddOrUpdateEvent{SubjectID: "new_user", OrgID: "o1", Active: true, DeliveryAttempts: 2})
+	adapter.Stop()
+
+	assert.Equal(t, []string{"failure"}, bus.reports())
+	assertNoDeadLetters(t, repo)
+}
+
+func TestFailedEventIsDeadLetteredAtMaxAttempts(t *testing.T) {
+	adapter, bus, repo := newEventAdapter(t, errors.New("store unavailable"))
+
+	bus.deliver(contracts.SubjectAddOrUpdateEvent{SubjectID: "new_user", OrgID: "o1", Active: true, DeliveryAttempts: 3, Payload: "<CanonicalMessage/>"})
+	adapter.Stop()
+
+	assert.Equal(t, []string{"deadlettered: store unavailable"}, bus.reports())
+	letters, err := repo.GetDeadLetters(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, letters, 1) {
+		assert.Equal(t, domain.SubjectID("new_user"), letters[0].SubjectID)
+		assert.Equal(t, "o1", letters[0].OrgID)
+		assert.True(t, letters[0].Active)
+		assert.Equal(t, "store unavailable", letters[0].Reason)
+		assert.Equal(t, 3, letters[0].Attempts)
+		assert.Equal(t, "<CanonicalMessage/>", letters[0].Payload)
+		assert.True(t, letters[0].Replayable())
+	}
+}
+
+func TestUnparseableMessageIsDeadLettered(t *testing.T) {
+	adapter, bus, repo := newEventAdapter(t, nil)
+
+	bus.unparseable <- contracts.UnparseableMessage{Payload: "<Message>", Err: errors.New("XML syntax error")}
+	adapter.Stop()
+
+	assert.Empty(t, bus.reports())
+	letters, err := repo.GetDeadLetters(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, letters, 1) {
+		assert.Equal(t, "<Message>", letters[0].Payload)
+		assert.Equal(t, "XML syntax error", letters[0].Reason)
+		assert.False(t, letters[0].Replayable())
+	}
+}
+
+func TestAdapterReportsConnectionStateOfBus(t *testing.T) {
+	adapter, _, _ := newEventAdapter(t, nil)
+	assert.True(t, adapter.IsConnected())
+
+	adapter.Stop()
+
+	assert.False(t, adapter.IsConnected())
+}
+
+func newEventAdapter(t *testing.T, upsertErr error) (*EventAdapter, *fakeBus, *memory.InMemoryDeadLetterRepository) {
+	repo, err := memory.NewSeededInMemoryAccessRepository()
+	assert.NoError(t, err)
+	principals := &mock.StubPrincipalRepository{}
+	licenses := application.NewLicenseAppService(repo, repo, principals, principals, failingOrgRepository{repo, upsertErr}, nil)
+
+	bus := newFakeBus()
+	deadLetters := memory.NewInMemoryDeadLetterRepository()
+	adapter := NewEventAdapter(licenses, bus, deadLetters, 3)
+	assert.NoError(t, adapter.Start())
+
+	return adapter, bus, deadLetters
+}
+
+func assertNoDeadLetters(t *testing.T, repo *memory.InMemoryDeadLetterRepository) {
+	letters, err := repo.GetDeadLetters(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, letters)
+}
+
+// failingOrgRepository fails to upsert subjects with the given error, if any
+type failingOrgRepository struct {
+	contracts.OrganizationRepository
+	err error
+}
+
+func (r failingOrgRepository) UpsertSubject(ctx context.Context, orgID string, subject domain.Subject) error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.OrganizationRepository.UpsertSubject(ctx, orgID, subject)
+}
+
+// fakeBus delivers events from the test and records how they were reported
+type fakeBus struct {
+	mu          sync.Mutex
+	changes     chan contracts.SubjectAddOrUpdateEvent
+	unparseable chan contracts.UnparseableMessage
+	errs        chan error
+	reported    []string
+	connected   bool
+}
+
+func newFakeBus() *fakeBus {
+	return &fakeBus{
+		changes:     make(chan contracts.SubjectAddOrUpdateEvent),
+		unparseable: make(chan contracts.UnparseableMessage),
+		errs:        make(chan error),
+	}
+}
+
+func (b *fakeBus) deliver(evt contracts.SubjectAddOrUpdateEvent) {
+	b.changes <- evt
+}
+
+func (b *fakeBus) reports() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.reported...)
+}
+
+func (b *fakeBus) report(result string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.reported = append(b.reported, result)
+	return nil
+}
+
+func (b *fakeBus) PublishLicenseEvents(context.Context, []domain.LicenseEvent) error {
+	return nil
+}
+
+func (b *fakeBus) Connect() (contracts.UserEvents, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.connected = true
+	return contracts.UserEvents{SubjectChanges: b.changes, Unparseable: b.unparseable, Errors: b.errs}, nil
+}
+
+func (b *fakeBus) Disconnect() {
+	b.mu.Lock()
+	b.connected = false
+	b.mu.Unlock()
+	close(b.errs)
+	close(b.changes)
+	close(b.unparseable)
+}
+
+func (b *fakeBus) IsConnected() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.connected
+}
+
+func (b *fakeBus) ReportSuccess(_ contracts.SubjectAddOrUpdateEvent) error {
+	return b.report("success")
+}
+
+func (b *fakeBus) ReportFailure(_ contracts.SubjectAddOrUpdateEvent) error {
+	return b.report("failure")
+}
+
+func (b *fakeBus) ReportDeadLettered(_ contracts.SubjectAddOrUpdateEvent, reason string) error {
+	return b.report("deadlettered: " + reason)
+}
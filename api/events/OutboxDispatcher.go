@@ -0,0 +1,113 @@
+package events
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// outboxBatchSize is the maximum number of events read from the outbox per dispatch
+const outboxBatchSize = 100
+
+// OutboxDispatcher periodically publishes the pending events of an outbox and marks them as sent. Events are delivered at least once and in the order they were recorded.
+type OutboxDispatcher struct {
+	outbox     contracts.OutboxRepository
+	publisher  contracts.LicenseEventPublisher
+	interval   time.Duration
+	maxBackoff time.Duration
+	stop       chan interface{}
+	done       chan interface{}
+}
+
+// NewOutboxDispatcher constructs a dispatcher that polls the outbox every interval. After failed dispatches, the delay doubles up to maxBackoff.
+func NewOutboxDispatcher(outbox contracts.OutboxRepository, publisher contracts.LicenseEventPublisher, interval time.Duration, maxBackoff time.Duration) *OutboxDispatcher {
+	if maxBackoff < interval {
+		maxBackoff = interval
+	}
+
+	return &OutboxDispatcher{
+		outbox:     outbox,
+		publisher:  publisher,
+		interval:   interval,
+		maxBackoff: maxBackoff,
+		stop:       make(chan interface{}),
+		done:       make(chan interface{}),
+	}
+}
+
+// Start begins dispatching in the background
+func (d *OutboxDispatcher) Start() {
+	go d.run()
+}
+
+func (d *OutboxDispatcher) run() {
+	delay := d.interval
+	for {
+		select {
+		case <-d.stop:
+			d.done <- struct{}{}
+			return
+		case <-time.After(delay):
+		}
+
+		if _, err := d.DispatchPending(context.Background()); err != nil {
+			delay *= 2
+			if delay > d.maxBackoff {
+				delay = d.maxBackoff
+			}
+			glog.Errorf("Error dispatching outbox events, retrying in %s: %v", delay, err)
+		} else {
+			delay = d.interval
+		}
+	}
+}
+
+// DispatchPending publishes all pending events, one at a time, and marks each published event as sent. It stops at the first failure, so that later events are not delivered before earlier ones, and returns the number of events sent.
+func (d *OutboxDispatcher) DispatchPending(ctx context.Context) (int, error) {
+	sent := 0
+	for {
+		evts, err := d.outbox.GetPendingEvents(ctx, outboxBatchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, evt := range evts {
+			if err := d.publisher.PublishLicenseEvents(ctx, []domain.LicenseEvent{evt.LicenseEvent}); err != nil {
+				return sent, err
+			}
+
+			// An event that was published but not marked is published again by the next dispatch
+			if err := d.outbox.MarkEventsSent(ctx, []string{evt.ID}); err != nil {
+				return sent, err
+			}
+			sent++
+		}
+
+		if len(evts) < outboxBatchSize {
+			return sent, nil
+		}
+	}
+}
+
+// Stop ends dispatching, completes any dispatch in progress, and then returns
+func (d *OutboxDispatcher) Stop() {
+	d.stop <- struct{}{}
+	<-d.done
+}
+
+// PublisherGroup publishes license events to each of its publishers in turn. It stops at the first failing publisher, so a retry publishes the events to the preceding publishers again.
+type PublisherGroup []contracts.LicenseEventPublisher
+
+// PublishLicenseEvents publishes the events to all publishers of the group
+func (g PublisherGroup) PublishLicenseEvents(ctx context.Context, evts []domain.LicenseEvent) error {
+	for _, publisher := range g {
+		if err := publisher.PublishLicenseEvents(ctx, evts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
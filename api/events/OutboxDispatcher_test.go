@@ -0,0 +1,113 @@
+package events
+
+import (
+	"authz/domain"
+	"authz/infrastructure/repository/memory"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatchPendingPublishesInOrderAndMarksSent(t *testing.T) {
+	repo := newOutboxRepository(t)
+	publisher := &flakyPublisher{}
+	dispatcher := NewOutboxDispatcher(repo, publisher, time.Second, time.Second)
+
+	sent, err := dispatcher.DispatchPending(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, sent)
+	assert.Equal(t, []domain.LicenseEventType{domain.LicenseEntitled, domain.SeatAssigned, domain.SeatAssigned}, publisher.types())
+	assertNoPendingEvents(t, repo)
+}
+
+func TestDispatchPendingStopsAtFirstFailureAndRetriesLater(t *testing.T) {
+	repo := newOutboxRepository(t)
+	publisher := &flakyPublisher{failures: 1, failAfter: 1}
+	dispatcher := NewOutboxDispatcher(repo, publisher, time.Second, time.Second)
+
+	sent, err := dispatcher.DispatchPending(context.Background())
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, sent)
+	pending, _ := repo.GetPendingEvents(context.Background(), 10)
+	assert.Len(t, pending, 2)
+
+	sent, err = dispatcher.DispatchPending(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.Equal(t, []domain.LicenseEventType{domain.LicenseEntitled, domain.SeatAssigned, domain.SeatAssigned}, publisher.types())
+	assertNoPendingEvents(t, repo)
+}
+
+func TestDispatcherRetriesInBackgroundUntilDelivered(t *testing.T) {
+	repo := newOutboxRepository(t)
+	publisher := &flakyPublisher{failures: 2}
+	dispatcher := NewOutboxDispatcher(repo, publisher, time.Millisecond, 4*time.Millisecond)
+
+	dispatcher.Start()
+	assert.Eventually(t, func() bool {
+		pending, err := repo.GetPendingEvents(context.Background(), 10)
+		return err == nil && len(pending) == 0
+	}, time.Second, time.Millisecond)
+	dispatcher.Stop()
+
+	assert.Equal(t, []domain.LicenseEventType{domain.LicenseEntitled, domain.SeatAssigned, domain.SeatAssigned}, publisher.types())
+}
+
+func newOutboxRepository(t *testing.T) *memory.InMemoryOutbox {
+	repo := memory.NewInMemoryAccessRepository()
+	outbox := memory.NewInMemoryOutbox()
+	repo.EnableOutbox(outbox)
+
+	license := &domain.License{OrgID: "o1", ServiceID: "smarts", MaxSeats: 5, Version: "l"}
+	assert.NoError(t, repo.ApplyLicense(context.Background(), license))
+	assert.NoError(t, repo.AddSubject(context.Background(), "o1", domain.Subject{SubjectID: "u1", Enabled: true}))
+	assert.NoError(t, repo.AddSubject(context.Background(), "o1", domain.Subject{SubjectID: "u2", Enabled: true}))
+	assert.NoError(t, repo.ModifySeats(context.Background(), []domain.SubjectID{"u1", "u2"}, nil, license, "o1", domain.Service{ID: "smarts"}))
+
+	return outbox
+}
+
+func assertNoPendingEvents(t *testing.T, repo *memory.InMemoryOutbox) {
+	pending, err := repo.GetPendingEvents(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+// flakyPublisher fails the given number of times after publishing failAfter events successfully
+type flakyPublisher struct {
+	mu        sync.Mutex
+	failures  int
+	failAfter int
+	published []domain.LicenseEvent
+}
+
+func (p *flakyPublisher) PublishLicenseEvents(_ context.Context, evts []domain.LicenseEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.failures > 0 && len(p.published) >= p.failAfter {
+		p.failures--
+		return errors.New("publishing failed")
+	}
+
+	p.published = append(p.published, evts...)
+	return nil
+}
+
+func (p *flakyPublisher) types() []domain.LicenseEventType {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var types []domain.LicenseEventType
+	for _, evt := range p.published {
+		types = append(types, evt.Type)
+	}
+	return types
+}
@@ -0,0 +1,58 @@
+package events
+
+import (
+	"authz/application"
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// SeatReclamationSweeper periodically reclaims the seats of disabled users in licenses that have seat reclamation enabled. It catches users who were disabled before seat reclamation was enabled, or whose change could not be processed.
+type SeatReclamationSweeper struct {
+	licenseAppService *application.LicenseAppService
+	interval          time.Duration
+	stop              chan interface{}
+	done              chan interface{}
+}
+
+// NewSeatReclamationSweeper constructs a sweeper that reclaims seats every interval
+func NewSeatReclamationSweeper(licenseAppService *application.LicenseAppService, interval time.Duration) *SeatReclamationSweeper {
+	return &SeatReclamationSweeper{
+		licenseAppService: licenseAppService,
+		interval:          interval,
+		stop:              make(chan interface{}),
+		done:              make(chan interface{}),
+	}
+}
+
+// Start begins sweeping in the background
+func (s *SeatReclamationSweeper) Start() {
+	go s.run()
+}
+
+func (s *SeatReclamationSweeper) run() {
+	for {
+		select {
+		case <-s.stop:
+			s.done <- struct{}{}
+			return
+		case <-time.After(s.interval):
+		}
+
+		// Failed licenses are retried by the next sweep
+		reclaimed, err := s.licenseAppService.ReclaimDisabledSeats(context.Background())
+		if err != nil {
+			glog.Errorf("Error reclaiming seats of disabled users: %v", err)
+		}
+		if reclaimed > 0 {
+			glog.Infof("Reclaimed %d seats of disabled users.", reclaimed)
+		}
+	}
+}
+
+// Stop ends sweeping, completes any sweep in progress, and then returns
+func (s *SeatReclamationSweeper) Stop() {
+	s.stop <- struct{}{}
+	<-s.done
+}
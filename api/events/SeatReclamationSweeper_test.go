@@ -0,0 +1,35 @@
+package events
+
+import (
+	"authz/application"
+	"authz/domain"
+	"authz/infrastructure/repository/memory"
+	"authz/infrastructure/repository/mock"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSweeperReclaimsSeatsOfUsersDisabledBeforeReclamationWasEnabled(t *testing.T) {
+	repo, err := memory.NewSeededInMemoryAccessRepository()
+	assert.NoError(t, err)
+	principals := &mock.StubPrincipalRepository{}
+	licenses := application.NewLicenseAppService(repo, repo, principals, principals, repo, nil)
+
+	// u3 is disabled but still assigned in the seed data
+	assert.NoError(t, repo.SetSeatReclamation(context.Background(), "o1", "smarts", true))
+
+	sweeper := NewSeatReclamationSweeper(licenses, time.Millisecond)
+	sweeper.Start()
+	assert.Eventually(t, func() bool {
+		assigned, err := repo.GetAssigned(context.Background(), "o1", "smarts")
+		return err == nil && assert.ObjectsAreEqual([]domain.SubjectID{"u1"}, assigned)
+	}, time.Second, time.Millisecond)
+	sweeper.Stop()
+
+	license, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, license.InUse)
+}
@@ -0,0 +1,213 @@
+package events
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// webhookBatchSize is the maximum number of pending deliveries read from the queue at once
+const webhookBatchSize = 100
+
+// WebhookDispatcher delivers license events to the webhooks subscribed to them. Publishing stores a pending delivery per subscribed webhook in a queue, so that the events stay in the outbox until their deliveries are stored.
+// The deliveries are made in the background and retried with exponential backoff until the webhook accepts them or the maximum number of attempts is reached. Every attempt is recorded in the delivery log.
+// Deliveries of different events are independent, so webhooks may receive them out of order. A delivery interrupted by a restart is made again.
+type WebhookDispatcher struct {
+	webhooks       contracts.WebhookRepository
+	sender         contracts.WebhookSender
+	deliveries     contracts.WebhookDeliveryLog
+	queue          contracts.WebhookDeliveryQueue
+	maxAttempts    int
+	initialBackoff time.Duration
+	wake           chan interface{}
+	stop           chan interface{}
+	cancel         context.CancelFunc
+	running        sync.WaitGroup
+}
+
+// NewWebhookDispatcher constructs a dispatcher making up to maxAttempts attempts per delivery, waiting initialBackoff after the first failed attempt and twice as long after each further one. The queue is checked for due deliveries at least every initialBackoff.
+func NewWebhookDispatcher(webhooks contracts.WebhookRepository, sender contracts.WebhookSender, deliveries contracts.WebhookDeliveryLog, queue contracts.WebhookDeliveryQueue, maxAttempts int, initialBackoff time.Duration) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		webhooks:       webhooks,
+		sender:         sender,
+		deliveries:     deliveries,
+		queue:          queue,
+		maxAttempts:    maxAttempts,
+		initialBackoff: initialBackoff,
+		wake:           make(chan interface{}, 1),
+		stop:           make(chan interface{}),
+	}
+}
+
+// PublishLicenseEvents stores a pending delivery of the events to each webhook subscribed to them. It fails if the deliveries could not be stored, the events have to be published again then.
+func (d *WebhookDispatcher) PublishLicenseEvents(ctx context.Context, evts []domain.LicenseEvent) error {
+	now := time.Now()
+
+	var pending []domain.PendingWebhookDelivery
+	webhooksByOrg := make(map[string][]domain.Webhook)
+	for _, evt := range evts {
+		webhooks, ok := webhooksByOrg[evt.OrgID]
+		if !ok {
+			var err error
+			webhooks, err = d.webhooks.GetWebhooks(ctx, evt.OrgID)
+			if err != nil {
+				return err
+			}
+			webhooksByOrg[evt.OrgID] = webhooks
+		}
+
+		for _, webhook := range webhooks {
+			if webhook.Matches(evt) {
+				pending = append(pending, domain.PendingWebhookDelivery{
+					ID:        domain.NewPendingWebhookDeliveryID(now),
+					WebhookID: webhook.ID,
+					OrgID:     webhook.OrgID,
+					Event:     evt,
+				})
+			}
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if err := d.queue.AddPendingDeliveries(ctx, pending); err != nil {
+		return err
+	}
+
+	select {
+	case d.wake <- struct{}{}:
+	default: // a wake-up is pending already
+	}
+
+	return nil
+}
+
+// Start begins delivering in the background, including the deliveries left pending by a previous run
+func (d *WebhookDispatcher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	d.running.Add(1)
+	go d.run(ctx)
+}
+
+func (d *WebhookDispatcher) run(ctx context.Context) {
+	defer d.running.Done()
+
+	for {
+		if _, err := d.DeliverDue(ctx); err != nil && ctx.Err() == nil {
+			glog.Errorf("Error delivering license events to webhooks: %v", err)
+		}
+
+		select {
+		case <-d.stop:
+			return
+		case <-d.wake:
+		case <-time.After(d.initialBackoff):
+		}
+	}
+}
+
+// DeliverDue makes an attempt for each pending delivery that is due and returns the number of successful deliveries. It stops at the first failure to access the queue, or when ctx is canceled.
+func (d *WebhookDispatcher) DeliverDue(ctx context.Context) (int, error) {
+	succeeded := 0
+	for {
+		due, err := d.queue.GetDueDeliveries(ctx, time.Now(), webhookBatchSize)
+		if err != nil {
+			return succeeded, err
+		}
+
+		// Each delivery is either removed or rescheduled, so the next batch only contains other deliveries
+		webhooksByOrg := make(map[string][]domain.Webhook)
+		for _, delivery := range due {
+			select {
+			case <-d.stop:
+				return succeeded, nil
+			default:
+			}
+
+			ok, err := d.attempt(ctx, delivery, webhooksByOrg)
+			if err != nil {
+				return succeeded, err
+			}
+			if ok {
+				succeeded++
+			}
+		}
+
+		if len(due) < webhookBatchSize {
+			return succeeded, nil
+		}
+	}
+}
+
+// attempt makes one attempt of a pending delivery and removes or reschedules it. It returns true if the webhook accepted the event.
+func (d *WebhookDispatcher) attempt(ctx context.Context, delivery domain.PendingWebhookDelivery, webhooksByOrg map[string][]domain.Webhook) (bool, error) {
+	webhooks, ok := webhooksByOrg[delivery.OrgID]
+	if !ok {
+		var err error
+		webhooks, err = d.webhooks.GetWebhooks(ctx, delivery.OrgID)
+		if err != nil {
+			return false, err
+		}
+		webhooksByOrg[delivery.OrgID] = webhooks
+	}
+
+	webhook, found := findWebhook(webhooks, delivery.WebhookID)
+	if !found {
+		// The webhook was removed after the event was published
+		return false, d.queue.RemovePendingDelivery(ctx, delivery.ID)
+	}
+
+	attempt := domain.WebhookDelivery{WebhookID: webhook.ID, Event: delivery.Event, Attempt: delivery.Attempts + 1, Time: time.Now()}
+	status, err := d.sender.Send(ctx, webhook, delivery.Event)
+	if err != nil && ctx.Err() != nil {
+		// The attempt was interrupted before the webhook answered, it is made again by the next run
+		return false, ctx.Err()
+	}
+	attempt.StatusCode = status
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+
+	if logErr := d.deliveries.AddDelivery(ctx, attempt); logErr != nil {
+		glog.Errorf("Error recording delivery to webhook %s: %v", webhook.ID, logErr)
+	}
+
+	if attempt.Succeeded() {
+		return true, d.queue.RemovePendingDelivery(ctx, delivery.ID)
+	}
+
+	if attempt.Attempt >= d.maxAttempts {
+		glog.Errorf("Giving up delivering %s event to webhook %s of org %s after %d attempts: %v", delivery.Event.Type, webhook.ID, webhook.OrgID, attempt.Attempt, err)
+		return false, d.queue.RemovePendingDelivery(ctx, delivery.ID)
+	}
+
+	delivery.Attempts = attempt.Attempt
+	delivery.NextAttempt = time.Now().Add(d.initialBackoff << (delivery.Attempts - 1))
+	return false, d.queue.UpdatePendingDelivery(ctx, delivery)
+}
+
+func findWebhook(webhooks []domain.Webhook, id string) (domain.Webhook, bool) {
+	for _, webhook := range webhooks {
+		if webhook.ID == id {
+			return webhook, true
+		}
+	}
+
+	return domain.Webhook{}, false
+}
+
+// Stop ends delivering, cancels the attempt in progress, waits for it to end, and then returns. Pending deliveries, including the canceled one, are made by the next run. No events must be published afterwards.
+func (d *WebhookDispatcher) Stop() {
+	close(d.stop)
+	if d.cancel != nil {
+		d.cancel()
+	}
+	d.running.Wait()
+}
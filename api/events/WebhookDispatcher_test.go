@@ -0,0 +1,280 @@
+package events
+
+import (
+	"authz/domain"
+	"authz/infrastructure/repository/memory"
+	"authz/infrastructure/repository/webhook"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookReceivesSignedEventsOfItsSubscription(t *testing.T) {
+	receiver := newWebhookReceiver(0)
+	defer receiver.Close()
+	dispatcher, repo, deliveries, _ := newWebhookDispatcher(t, receiver, 3)
+	subscribed := addWebhook(t, repo, receiver, "o1", "smarts")
+	allServices := addWebhook(t, repo, receiver, "o1", "")
+	addWebhook(t, repo, receiver, "o2", "smarts")
+	addWebhook(t, repo, receiver, "o1", "other")
+
+	err := dispatcher.PublishLicenseEvents(context.Background(), []domain.LicenseEvent{{Type: domain.SeatAssigned, OrgID: "o1", ServiceID: "smarts", SubjectID: "u1", SeatsTotal: 5, SeatsAvailable: 4}})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return len(receiver.received()) == 2 }, time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool { return deliveriesLogged(t, deliveries, subscribed, allServices) == 2 }, time.Second, time.Millisecond)
+	dispatcher.Stop()
+
+	assert.Len(t, receiver.received(), 2)
+	for _, req := range receiver.received() {
+		assert.Equal(t, webhook.Sign("secret-of-o1-smarts", req.body), req.signature) // all o1 hooks share a secret
+		assert.Equal(t, "SeatAssigned", req.payload["eventType"])
+		assert.Equal(t, "u1", req.payload["subjectId"])
+	}
+
+	for _, hook := range []domain.Webhook{subscribed, allServices} {
+		logged, err := deliveries.GetDeliveries(context.Background(), hook.ID)
+		assert.NoError(t, err)
+		if assert.Len(t, logged, 1) {
+			assert.True(t, logged[0].Succeeded())
+			assert.Equal(t, http.StatusOK, logged[0].StatusCode)
+			assert.Equal(t, 1, logged[0].Attempt)
+		}
+	}
+}
+
+func TestWebhookDeliveryIsRetriedWithBackoff(t *testing.T) {
+	receiver := newWebhookReceiver(2)
+	defer receiver.Close()
+	dispatcher, repo, deliveries, _ := newWebhookDispatcher(t, receiver, 5)
+	hook := addWebhook(t, repo, receiver, "o1", "smarts")
+
+	err := dispatcher.PublishLicenseEvents(context.Background(), []domain.LicenseEvent{{Type: domain.LicenseEntitled, OrgID: "o1", ServiceID: "smarts", SeatsTotal: 5, SeatsAvailable: 5}})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool { return deliveriesLogged(t, deliveries, hook) == 3 }, time.Second, time.Millisecond)
+	dispatcher.Stop()
+
+	assert.Len(t, receiver.received(), 1)
+	logged, err := deliveries.GetDeliveries(context.Background(), hook.ID)
+	assert.NoError(t, err)
+	if assert.Len(t, logged, 3) {
+		assert.True(t, logged[0].Succeeded())
+		assert.Equal(t, 3, logged[0].Attempt)
+		assert.Equal(t, http.StatusInternalServerError, logged[1].StatusCode)
+		assert.False(t, logged[1].Succeeded())
+		assert.GreaterOrEqual(t, logged[1].Time.Sub(logged[2].Time), 5*time.Millisecond)
+		assert.GreaterOrEqual(t, logged[0].Time.Sub(logged[1].Time), 10*time.Millisecond)
+	}
+}
+
+func TestWebhookDeliveryGivesUpAfterMaxAttempts(t *testing.T) {
+	receiver := newWebhookReceiver(10)
+	defer receiver.Close()
+	dispatcher, repo, deliveries, queue := newWebhookDispatcher(t, receiver, 3)
+	hook := addWebhook(t, repo, receiver, "o1", "smarts")
+
+	err := dispatcher.PublishLicenseEvents(context.Background(), []domain.LicenseEvent{{Type: domain.LicenseEntitled, OrgID: "o1", ServiceID: "smarts"}})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		logged, _ := deliveries.GetDeliveries(context.Background(), hook.ID)
+		return len(logged) == 3
+	}, time.Second, time.Millisecond)
+	dispatcher.Stop()
+
+	assert.Empty(t, receiver.received())
+	assert.Equal(t, 3, receiver.attempts())
+	assert.Empty(t, pendingDeliveries(t, queue))
+}
+
+func TestPendingDeliveriesAreMadeAfterRestart(t *testing.T) {
+	receiver := newWebhookReceiver(0)
+	defer receiver.Close()
+	repo := memory.NewInMemoryWebhookRepository()
+	queue := memory.NewInMemoryWebhookDeliveryQueue()
+	sender := webhook.NewHTTPWebhookSender(receiver.Client())
+	addWebhook(t, repo, receiver, "o1", "smarts")
+
+	// Not started, like a replica stopped right after publishing
+	stopped := NewWebhookDispatcher(repo, sender, memory.NewInMemoryWebhookDeliveryLog(10), queue, 3, 5*time.Millisecond)
+	err := stopped.PublishLicenseEvents(context.Background(), []domain.LicenseEvent{{Type: domain.LicenseEntitled, OrgID: "o1", ServiceID: "smarts"}})
+	assert.NoError(t, err)
+	stopped.Stop()
+	assert.Empty(t, receiver.received())
+	assert.Len(t, pendingDeliveries(t, queue), 1)
+
+	restarted := NewWebhookDispatcher(repo, sender, memory.NewInMemoryWebhookDeliveryLog(10), queue, 3, 5*time.Millisecond)
+	restarted.Start()
+	assert.Eventually(t, func() bool { return len(pendingDeliveries(t, queue)) == 0 }, time.Second, time.Millisecond)
+	restarted.Stop()
+
+	assert.Len(t, receiver.received(), 1)
+}
+
+func TestStopCancelsTheAttemptInProgress(t *testing.T) {
+	requested := make(chan struct{}, 1)
+	release := make(chan struct{})
+	receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested <- struct{}{}
+		<-release
+	}))
+	defer receiver.Close()
+	defer close(release)
+	repo := memory.NewInMemoryWebhookRepository()
+	deliveries := memory.NewInMemoryWebhookDeliveryLog(10)
+	queue := memory.NewInMemoryWebhookDeliveryQueue()
+	hook := domain.Webhook{ID: domain.NewWebhookID(), OrgID: "o1", URL: receiver.URL, Secret: "s3cr3t"}
+	assert.NoError(t, repo.AddWebhook(context.Background(), hook))
+	dispatcher := NewWebhookDispatcher(repo, webhook.NewHTTPWebhookSender(receiver.Client()), deliveries, queue, 3, time.Hour)
+	dispatcher.Start()
+
+	err := dispatcher.PublishLicenseEvents(context.Background(), []domain.LicenseEvent{{Type: domain.LicenseEntitled, OrgID: "o1", ServiceID: "smarts"}})
+	assert.NoError(t, err)
+	<-requested
+	dispatcher.Stop()
+
+	logged, err := deliveries.GetDeliveries(context.Background(), hook.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, logged)
+	if pending := pendingDeliveries(t, queue); assert.Len(t, pending, 1) {
+		assert.Equal(t, 0, pending[0].Attempts)
+	}
+}
+
+func TestPublishingFailsWhenDeliveriesCannotBeStored(t *testing.T) {
+	receiver := newWebhookReceiver(0)
+	defer receiver.Close()
+	repo := memory.NewInMemoryWebhookRepository()
+	addWebhook(t, repo, receiver, "o1", "smarts")
+	dispatcher := NewWebhookDispatcher(repo, webhook.NewHTTPWebhookSender(receiver.Client()), memory.NewInMemoryWebhookDeliveryLog(10), failingQueue{}, 3, 5*time.Millisecond)
+
+	err := dispatcher.PublishLicenseEvents(context.Background(), []domain.LicenseEvent{{Type: domain.LicenseEntitled, OrgID: "o1", ServiceID: "smarts"}})
+
+	assert.ErrorIs(t, err, errQueueUnavailable)
+}
+
+func TestDeliveriesToRemovedWebhooksAreDropped(t *testing.T) {
+	receiver := newWebhookReceiver(0)
+	defer receiver.Close()
+	repo := memory.NewInMemoryWebhookRepository()
+	queue := memory.NewInMemoryWebhookDeliveryQueue()
+	hook := addWebhook(t, repo, receiver, "o1", "smarts")
+	dispatcher := NewWebhookDispatcher(repo, webhook.NewHTTPWebhookSender(receiver.Client()), memory.NewInMemoryWebhookDeliveryLog(10), queue, 3, 5*time.Millisecond)
+
+	err := dispatcher.PublishLicenseEvents(context.Background(), []domain.LicenseEvent{{Type: domain.LicenseEntitled, OrgID: "o1", ServiceID: "smarts"}})
+	assert.NoError(t, err)
+	assert.NoError(t, repo.RemoveWebhook(context.Background(), "o1", hook.ID))
+
+	sent, err := dispatcher.DeliverDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Empty(t, receiver.received())
+	assert.Empty(t, pendingDeliveries(t, queue))
+}
+
+func newWebhookDispatcher(t *testing.T, receiver *webhookReceiver, maxAttempts int) (*WebhookDispatcher, *memory.InMemoryWebhookRepository, *memory.InMemoryWebhookDeliveryLog, *memory.InMemoryWebhookDeliveryQueue) {
+	repo := memory.NewInMemoryWebhookRepository()
+	deliveries := memory.NewInMemoryWebhookDeliveryLog(10)
+	queue := memory.NewInMemoryWebhookDeliveryQueue()
+	sender := webhook.NewHTTPWebhookSender(receiver.Client())
+
+	dispatcher := NewWebhookDispatcher(repo, sender, deliveries, queue, maxAttempts, 5*time.Millisecond)
+	dispatcher.Start()
+	return dispatcher, repo, deliveries, queue
+}
+
+func deliveriesLogged(t *testing.T, deliveries *memory.InMemoryWebhookDeliveryLog, hooks ...domain.Webhook) int {
+	count := 0
+	for _, hook := range hooks {
+		logged, err := deliveries.GetDeliveries(context.Background(), hook.ID)
+		assert.NoError(t, err)
+		count += len(logged)
+	}
+	return count
+}
+
+func pendingDeliveries(t *testing.T, queue *memory.InMemoryWebhookDeliveryQueue) []domain.PendingWebhookDelivery {
+	pending, err := queue.GetDueDeliveries(context.Background(), time.Now().Add(time.Hour), 100)
+	assert.NoError(t, err)
+	return pending
+}
+
+var errQueueUnavailable = errors.New("queue unavailable")
+
+// failingQueue is a WebhookDeliveryQueue that cannot store deliveries
+type failingQueue struct{}
+
+func (failingQueue) AddPendingDeliveries(context.Context, []domain.PendingWebhookDelivery) error {
+	return errQueueUnavailable
+}
+
+func (failingQueue) GetDueDeliveries(context.Context, time.Time, int) ([]domain.PendingWebhookDelivery, error) {
+	return nil, errQueueUnavailable
+}
+
+func (failingQueue) UpdatePendingDelivery(context.Context, domain.PendingWebhookDelivery) error {
+	return errQueueUnavailable
+}
+
+func (failingQueue) RemovePendingDelivery(context.Context, string) error {
+	return errQueueUnavailable
+}
+
+func addWebhook(t *testing.T, repo *memory.InMemoryWebhookRepository, receiver *webhookReceiver, orgID string, serviceID string) domain.Webhook {
+	hook := domain.Webhook{ID: domain.NewWebhookID(), OrgID: orgID, ServiceID: serviceID, URL: receiver.URL, Secret: "secret-of-" + orgID + "-smarts"}
+	assert.NoError(t, repo.AddWebhook(context.Background(), hook))
+	return hook
+}
+
+type receivedRequest struct {
+	body      []byte
+	signature string
+	payload   map[string]interface{}
+}
+
+// webhookReceiver is an HTTPS endpoint that fails the given number of requests before accepting them
+type webhookReceiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	failures int
+	tries    int
+	requests []receivedRequest
+}
+
+func newWebhookReceiver(failures int) *webhookReceiver {
+	r := &webhookReceiver{failures: failures}
+	r.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.tries++
+		if r.tries <= r.failures {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		body, _ := io.ReadAll(req.Body)
+		received := receivedRequest{body: body, signature: req.Header.Get(webhook.SignatureHeader)}
+		_ = json.Unmarshal(body, &received.payload)
+		r.requests = append(r.requests, received)
+	}))
+	return r
+}
+
+func (r *webhookReceiver) received() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedRequest(nil), r.requests...)
+}
+
+func (r *webhookReceiver) attempts() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.tries
+}
@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	core "authz/api/gen/v1alpha"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// healthServer implements the standard gRPC health service on top of the readiness of the server
+type healthServer struct {
+	server *Server
+}
+
+// Check reports SERVING if the service is ready. The overall status is requested with an empty service name, the status of any service the server hosts is the same.
+func (h *healthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if req.Service != "" {
+		if _, ok := h.server.srv.GetServiceInfo()[req.Service]; !ok {
+			return nil, status.Error(codes.NotFound, "Unknown service.")
+		}
+	}
+
+	if _, err := h.server.Readiness(ctx, &core.Empty{}); err != nil {
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}, nil
+	}
+
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+// Watch is not supported, clients fall back to polling Check
+func (h *healthServer) Watch(_ *healthpb.HealthCheckRequest, _ healthpb.Health_WatchServer) error {
+	return status.Error(codes.Unimplemented, "Watch is not supported, use Check.")
+}
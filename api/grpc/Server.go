@@ -14,16 +14,25 @@ import (
 	"sync"
 
 	"github.com/golang/glog"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Server represents a Server host service
 type Server struct {
-	srv               *grpc.Server
-	AccessAppService  *application.AccessAppService
-	LicenseAppService *application.LicenseAppService
-	ServiceConfig     *serviceconfig.ServiceConfig
+	srv                  *grpc.Server
+	AccessAppService     *application.AccessAppService
+	LicenseAppService    *application.LicenseAppService
+	WebhookAppService    *application.WebhookAppService    // nil if webhooks are disabled
+	DeadLetterAppService *application.DeadLetterAppService // nil if the UMB is disabled
+	AuditAppService      *application.AuditAppService      // nil if the audit log cannot be queried
+	HealthAppService     *application.HealthAppService     // nil if no dependencies are probed
+	ServiceConfig        *serviceconfig.ServiceConfig
 }
 
 // GetLicense returns licenses for a given org and service
@@ -33,54 +42,126 @@ func (s *Server) GetLicense(ctx context.Context, grpcReq *core.GetLicenseRequest
 		return nil, err
 	}
 
-	// Validate if the requestor is the orgAdmin of the org in the req
-	requestorOrgAdmin := s.getIsOrgAdminFromGrpcContext(ctx)
-	requestorOrgID := s.getRequestorOrgIDFromGrpcContext(ctx)
+	// The identity provider's org admin claim is one way to be authorized, the store may grant others
+	requestorOrgAdmin := isRequestorOrgAdmin(s.getIsOrgAdminFromGrpcContext(ctx), s.getRequestorOrgIDFromGrpcContext(ctx), grpcReq.OrgId)
 
-	if !isRequestorOrgAdmin(requestorOrgAdmin, requestorOrgID, grpcReq.OrgId) {
-		return nil, domain.ErrNotAuthorized
+	req := application.GetSeatAssignmentCountsRequest{
+		Requestor:           requestor,
+		RequestorIsOrgAdmin: requestorOrgAdmin,
+		OrgID:               grpcReq.OrgId,
+		ServiceID:           grpcReq.ServiceId,
+	}
+	lic, err := s.LicenseAppService.GetLicense(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
-	req := application.GetSeatAssignmentCountsRequest{
-		Requestor: requestor,
-		OrgID:     grpcReq.OrgId,
-		ServiceID: grpcReq.ServiceId,
+	resp := &core.GetLicenseResponse{
+		SeatsTotal:           int64(lic.MaxSeats),
+		SeatsAvailable:       int64(lic.GetAvailableSeats()),
+		ReclaimDisabledSeats: lic.ReclaimDisabledSeats,
+	}
+	if !lic.StartDate.IsZero() {
+		resp.StartDate = timestamppb.New(lic.StartDate)
 	}
-	limit, available, err := s.LicenseAppService.GetSeatAssignmentCounts(req)
+	if !lic.EndDate.IsZero() {
+		resp.EndDate = timestamppb.New(lic.EndDate)
+	}
+
+	return resp, nil
+}
+
+// ListLicenses returns all licenses of the given org
+func (s *Server) ListLicenses(ctx context.Context, grpcReq *core.ListLicensesRequest) (*core.ListLicensesResponse, error) {
+	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &core.GetLicenseResponse{
-		SeatsTotal:     int64(limit),
-		SeatsAvailable: int64(available),
-	}, nil
+	// The identity provider's org admin claim is one way to be authorized, the store may grant others
+	requestorOrgAdmin := isRequestorOrgAdmin(s.getIsOrgAdminFromGrpcContext(ctx), s.getRequestorOrgIDFromGrpcContext(ctx), grpcReq.OrgId)
+
+	req := application.ListLicensesRequest{
+		Requestor:           requestor,
+		RequestorIsOrgAdmin: requestorOrgAdmin,
+		OrgID:               grpcReq.OrgId,
+	}
+	licenses, err := s.LicenseAppService.ListLicenses(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &core.ListLicensesResponse{Licenses: make([]*core.LicenseSummary, len(licenses))}
+	for i, lic := range licenses {
+		resp.Licenses[i] = &core.LicenseSummary{
+			ServiceId:            lic.ServiceID,
+			SeatsTotal:           int64(lic.MaxSeats),
+			SeatsAvailable:       int64(lic.GetAvailableSeats()),
+			ReclaimDisabledSeats: lic.ReclaimDisabledSeats,
+		}
+		if !lic.StartDate.IsZero() {
+			resp.Licenses[i].StartDate = timestamppb.New(lic.StartDate)
+		}
+		if !lic.EndDate.IsZero() {
+			resp.Licenses[i].EndDate = timestamppb.New(lic.EndDate)
+		}
+	}
+
+	return resp, nil
 }
 
-// ModifySeats assigns and/or unassigns users to/from seats for a given org and service
-func (s *Server) ModifySeats(ctx context.Context, grpcReq *core.ModifySeatsRequest) (*core.ModifySeatsResponse, error) {
+// GetUserLicenses returns the licenses a user of the given org is assigned seats in. Users may get their own licenses.
+func (s *Server) GetUserLicenses(ctx context.Context, grpcReq *core.GetUserLicensesRequest) (*core.GetUserLicensesResponse, error) {
 	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Validate if the requestor is the orgAdmin of the org in the req
-	requestorOrgAdmin := s.getIsOrgAdminFromGrpcContext(ctx)
-	requestorOrgID := s.getRequestorOrgIDFromGrpcContext(ctx)
+	// The identity provider's org admin claim is one way to be authorized, the store may grant others
+	requestorOrgAdmin := isRequestorOrgAdmin(s.getIsOrgAdminFromGrpcContext(ctx), s.getRequestorOrgIDFromGrpcContext(ctx), grpcReq.OrgId)
 
-	if !isRequestorOrgAdmin(requestorOrgAdmin, requestorOrgID, grpcReq.OrgId) {
-		return nil, domain.ErrNotAuthorized
+	req := application.GetUserLicensesRequest{
+		Requestor:           requestor,
+		RequestorIsOrgAdmin: requestorOrgAdmin,
+		OrgID:               grpcReq.OrgId,
+		UserID:              grpcReq.UserId,
+	}
+	assignments, err := s.LicenseAppService.GetUserLicenses(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &core.GetUserLicensesResponse{Licenses: make([]*core.UserLicense, len(assignments))}
+	for i, a := range assignments {
+		resp.Licenses[i] = &core.UserLicense{ServiceId: a.ServiceID}
+		if !a.AssignedAt.IsZero() {
+			resp.Licenses[i].AssignedAt = timestamppb.New(a.AssignedAt)
+		}
+	}
+
+	return resp, nil
+}
+
+// ModifySeats assigns and/or unassigns users to/from seats for a given org and service
+func (s *Server) ModifySeats(ctx context.Context, grpcReq *core.ModifySeatsRequest) (*core.ModifySeatsResponse, error) {
+	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	// The identity provider's org admin claim is one way to be authorized, the store may grant others
+	requestorOrgAdmin := isRequestorOrgAdmin(s.getIsOrgAdminFromGrpcContext(ctx), s.getRequestorOrgIDFromGrpcContext(ctx), grpcReq.OrgId)
+
 	req := application.ModifySeatAssignmentRequest{
-		Requestor: requestor,
-		OrgID:     grpcReq.OrgId,
-		ServiceID: grpcReq.ServiceId,
-		Assign:    grpcReq.Assign,
-		Unassign:  grpcReq.Unassign,
+		Requestor:           requestor,
+		RequestorIsOrgAdmin: requestorOrgAdmin,
+		OrgID:               grpcReq.OrgId,
+		ServiceID:           grpcReq.ServiceId,
+		Assign:              grpcReq.Assign,
+		Unassign:            grpcReq.Unassign,
 	}
 
-	err = s.LicenseAppService.ModifySeats(req)
+	err = s.LicenseAppService.ModifySeats(ctx, req)
 
 	if err != nil {
 		return nil, err
@@ -95,13 +176,8 @@ func (s *Server) GetSeats(ctx context.Context, grpcReq *core.GetSeatsRequest) (*
 		return nil, err
 	}
 
-	// Validate if the requestor is the orgAdmin of the org in the req
-	requestorOrgAdmin := s.getIsOrgAdminFromGrpcContext(ctx)
-	requestorOrgID := s.getRequestorOrgIDFromGrpcContext(ctx)
-
-	if !isRequestorOrgAdmin(requestorOrgAdmin, requestorOrgID, grpcReq.OrgId) {
-		return nil, domain.ErrNotAuthorized
-	}
+	// The identity provider's org admin claim is one way to be authorized, the store may grant others
+	requestorOrgAdmin := isRequestorOrgAdmin(s.getIsOrgAdminFromGrpcContext(ctx), s.getRequestorOrgIDFromGrpcContext(ctx), grpcReq.OrgId)
 
 	includeUsers := true
 	if grpcReq.IncludeUsers != nil {
@@ -120,20 +196,28 @@ func (s *Server) GetSeats(ctx context.Context, grpcReq *core.GetSeatsRequest) (*
 	}
 
 	req := application.GetSeatAssignmentRequest{
-		Requestor:    requestor,
-		OrgID:        grpcReq.OrgId,
-		ServiceID:    grpcReq.ServiceId,
-		IncludeUsers: includeUsers,
-		Assigned:     assigned,
+		Requestor:           requestor,
+		RequestorIsOrgAdmin: requestorOrgAdmin,
+		OrgID:               grpcReq.OrgId,
+		ServiceID:           grpcReq.ServiceId,
+		IncludeUsers:        includeUsers,
+		Assigned:            assigned,
+		PageSize:            int(grpcReq.GetPageSize()),
+		PageToken:           grpcReq.GetPageToken(),
+		Search:              grpcReq.GetSearch(),
+	}
+
+	if grpcReq.SortBy != nil {
+		req.SortBy = grpcReq.SortBy.String()
 	}
 
-	principals, err := s.LicenseAppService.GetSeatAssignments(req)
+	result, err := s.LicenseAppService.GetSeatAssignments(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &core.GetSeatsResponse{Users: make([]*core.GetSeatsUserRepresentation, len(principals))}
-	for i, p := range principals {
+	resp := &core.GetSeatsResponse{Users: make([]*core.GetSeatsUserRepresentation, len(result.Principals)), NextPageToken: result.NextPageToken}
+	for i, p := range result.Principals {
 		resp.Users[i] = &core.GetSeatsUserRepresentation{
 			DisplayName: p.DisplayName(),
 			Id:          string(p.ID),
@@ -148,7 +232,7 @@ func (s *Server) GetSeats(ctx context.Context, grpcReq *core.GetSeatsRequest) (*
 }
 
 // EntitleOrg entitles an Org for a license to an existing service.
-// TODO: This is a temporary domain endpoint / handler until we get this from another service. So e.g. no serviceId-exists check will be added. We assume that the service is already there instead for now. Also updates not included for now.
+// TODO: This is a temporary domain endpoint / handler until we get this from another service. So e.g. no serviceId-exists check will be added. We assume that the service is already there instead for now. Use UpdateEntitlement to change the seats of an existing entitlement.
 func (s *Server) EntitleOrg(ctx context.Context, entitleOrgReq *core.EntitleOrgRequest) (*core.EntitleOrgResponse, error) {
 	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
 	if err != nil {
@@ -168,9 +252,16 @@ func (s *Server) EntitleOrg(ctx context.Context, entitleOrgReq *core.EntitleOrgR
 		OrgID:     entitleOrgReq.OrgId,
 		ServiceID: entitleOrgReq.ServiceId,
 		MaxSeats:  int(entitleOrgReq.MaxSeats),
+		Requestor: requestor,
+	}
+	if entitleOrgReq.StartDate != nil {
+		evt.StartDate = entitleOrgReq.StartDate.AsTime()
+	}
+	if entitleOrgReq.EndDate != nil {
+		evt.EndDate = entitleOrgReq.EndDate.AsTime()
 	}
 
-	err = s.LicenseAppService.HandleOrgEntitledEvent(evt)
+	err = s.LicenseAppService.HandleOrgEntitledEvent(ctx, evt)
 	if err != nil {
 		return nil, err
 	}
@@ -180,6 +271,326 @@ func (s *Server) EntitleOrg(ctx context.Context, entitleOrgReq *core.EntitleOrgR
 	return resp, nil
 }
 
+// UpdateEntitlement changes the number of seats of an existing license, applying the configured downgrade policy.
+func (s *Server) UpdateEntitlement(ctx context.Context, updateReq *core.UpdateEntitlementRequest) (*core.UpdateEntitlementResponse, error) {
+	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !sliceContains(s.ServiceConfig.AuthzConfig.LicenseImportAllowlist, requestor) {
+		glog.Infof("Received request to update entitlement of Org: %s from Requestor: %s. Requestor not authorized. ", updateReq.OrgId, requestor)
+		return nil, domain.ErrNotAuthorized
+	}
+
+	glog.Infof("Received request to update entitlement of Org: %s for service %s to %v seats from Requestor: %s", updateReq.OrgId, updateReq.ServiceId, updateReq.MaxSeats, requestor)
+	evt := application.EntitlementUpdatedEvent{
+		OrgID:           updateReq.OrgId,
+		ServiceID:       updateReq.ServiceId,
+		MaxSeats:        int(updateReq.MaxSeats),
+		DowngradePolicy: s.ServiceConfig.LicenseConfig.DowngradePolicy,
+		Requestor:       requestor,
+	}
+
+	result, err := s.LicenseAppService.HandleEntitlementUpdatedEvent(ctx, evt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.UpdateEntitlementResponse{
+		SeatsTotal:     int64(result.SeatsTotal),
+		SeatsAvailable: int64(result.SeatsAvailable),
+		SeatsOverage:   int64(result.SeatsOverage),
+	}, nil
+}
+
+// RevokeEntitlement removes the license of an org for a service including all seat assignments.
+func (s *Server) RevokeEntitlement(ctx context.Context, revokeReq *core.RevokeEntitlementRequest) (*core.RevokeEntitlementResponse, error) {
+	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !sliceContains(s.ServiceConfig.AuthzConfig.LicenseImportAllowlist, requestor) {
+		glog.Infof("Received request to revoke entitlement of Org: %s from Requestor: %s. Requestor not authorized. ", revokeReq.OrgId, requestor)
+		return nil, domain.ErrNotAuthorized
+	}
+
+	glog.Infof("Received request to revoke entitlement of Org: %s for service %s from Requestor: %s", revokeReq.OrgId, revokeReq.ServiceId, requestor)
+	evt := application.EntitlementRevokedEvent{
+		OrgID:     revokeReq.OrgId,
+		ServiceID: revokeReq.ServiceId,
+		Requestor: requestor,
+	}
+
+	err = s.LicenseAppService.HandleEntitlementRevokedEvent(ctx, evt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.RevokeEntitlementResponse{}, nil
+}
+
+// GrantLicenseAdmin allows a user to manage the license of an org for a service
+func (s *Server) GrantLicenseAdmin(ctx context.Context, grpcReq *core.GrantLicenseAdminRequest) (*core.GrantLicenseAdminResponse, error) {
+	req, err := s.licenseAdminRequest(ctx, grpcReq.OrgId, grpcReq.ServiceId, grpcReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.LicenseAppService.GrantLicenseAdmin(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.GrantLicenseAdminResponse{}, nil
+}
+
+// RevokeLicenseAdmin withdraws the permission of a user to manage the license of an org for a service
+func (s *Server) RevokeLicenseAdmin(ctx context.Context, grpcReq *core.RevokeLicenseAdminRequest) (*core.RevokeLicenseAdminResponse, error) {
+	req, err := s.licenseAdminRequest(ctx, grpcReq.OrgId, grpcReq.ServiceId, grpcReq.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.LicenseAppService.RevokeLicenseAdmin(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.RevokeLicenseAdminResponse{}, nil
+}
+
+// SetSeatReclamation enables or disables the automatic unassignment of seats held by disabled users for the license of an org for a service
+func (s *Server) SetSeatReclamation(ctx context.Context, grpcReq *core.SetSeatReclamationRequest) (*core.SetSeatReclamationResponse, error) {
+	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.LicenseAppService.SetSeatReclamation(ctx, application.SeatReclamationRequest{
+		Requestor:           requestor,
+		RequestorIsOrgAdmin: isRequestorOrgAdmin(s.getIsOrgAdminFromGrpcContext(ctx), s.getRequestorOrgIDFromGrpcContext(ctx), grpcReq.OrgId),
+		OrgID:               grpcReq.OrgId,
+		ServiceID:           grpcReq.ServiceId,
+		Enabled:             grpcReq.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.SetSeatReclamationResponse{}, nil
+}
+
+func (s *Server) licenseAdminRequest(ctx context.Context, orgID string, serviceID string, userID string) (application.LicenseAdminRequest, error) {
+	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
+	if err != nil {
+		return application.LicenseAdminRequest{}, err
+	}
+
+	return application.LicenseAdminRequest{
+		Requestor:           requestor,
+		RequestorIsOrgAdmin: isRequestorOrgAdmin(s.getIsOrgAdminFromGrpcContext(ctx), s.getRequestorOrgIDFromGrpcContext(ctx), orgID),
+		OrgID:               orgID,
+		ServiceID:           serviceID,
+		UserID:              userID,
+	}, nil
+}
+
+// CreateWebhook registers a webhook for the license events of an org
+func (s *Server) CreateWebhook(ctx context.Context, grpcReq *core.CreateWebhookRequest) (*core.CreateWebhookResponse, error) {
+	if err := s.ensureWebhooksEnabled(); err != nil {
+		return nil, err
+	}
+
+	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.WebhookAppService.CreateWebhook(ctx, application.CreateWebhookRequest{
+		Requestor:           requestor,
+		RequestorIsOrgAdmin: isRequestorOrgAdmin(s.getIsOrgAdminFromGrpcContext(ctx), s.getRequestorOrgIDFromGrpcContext(ctx), grpcReq.OrgId),
+		OrgID:               grpcReq.OrgId,
+		ServiceID:           grpcReq.ServiceId,
+		URL:                 grpcReq.Url,
+		Secret:              grpcReq.Secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.CreateWebhookResponse{Webhook: webhookToAPI(webhook)}, nil
+}
+
+// ListWebhooks returns all webhooks of the given org
+func (s *Server) ListWebhooks(ctx context.Context, grpcReq *core.ListWebhooksRequest) (*core.ListWebhooksResponse, error) {
+	if err := s.ensureWebhooksEnabled(); err != nil {
+		return nil, err
+	}
+
+	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.WebhookAppService.ListWebhooks(ctx, application.ListWebhooksRequest{
+		Requestor:           requestor,
+		RequestorIsOrgAdmin: isRequestorOrgAdmin(s.getIsOrgAdminFromGrpcContext(ctx), s.getRequestorOrgIDFromGrpcContext(ctx), grpcReq.OrgId),
+		OrgID:               grpcReq.OrgId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &core.ListWebhooksResponse{Webhooks: make([]*core.Webhook, len(webhooks))}
+	for i, webhook := range webhooks {
+		resp.Webhooks[i] = webhookToAPI(webhook)
+	}
+
+	return resp, nil
+}
+
+// DeleteWebhook removes a webhook of the given org
+func (s *Server) DeleteWebhook(ctx context.Context, grpcReq *core.DeleteWebhookRequest) (*core.DeleteWebhookResponse, error) {
+	req, err := s.webhookRequest(ctx, grpcReq.OrgId, grpcReq.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.WebhookAppService.DeleteWebhook(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.DeleteWebhookResponse{}, nil
+}
+
+// ListWebhookDeliveries returns the recent delivery attempts to a webhook of the given org
+func (s *Server) ListWebhookDeliveries(ctx context.Context, grpcReq *core.ListWebhookDeliveriesRequest) (*core.ListWebhookDeliveriesResponse, error) {
+	req, err := s.webhookRequest(ctx, grpcReq.OrgId, grpcReq.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.WebhookAppService.ListWebhookDeliveries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &core.ListWebhookDeliveriesResponse{Deliveries: make([]*core.WebhookDelivery, len(deliveries))}
+	for i, delivery := range deliveries {
+		resp.Deliveries[i] = &core.WebhookDelivery{
+			EventType:  string(delivery.Event.Type),
+			ServiceId:  delivery.Event.ServiceID,
+			SubjectId:  string(delivery.Event.SubjectID),
+			Attempt:    int32(delivery.Attempt),
+			Time:       timestamppb.New(delivery.Time),
+			StatusCode: int32(delivery.StatusCode),
+			Error:      delivery.Error,
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *Server) webhookRequest(ctx context.Context, orgID string, webhookID string) (application.WebhookRequest, error) {
+	if err := s.ensureWebhooksEnabled(); err != nil {
+		return application.WebhookRequest{}, err
+	}
+
+	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
+	if err != nil {
+		return application.WebhookRequest{}, err
+	}
+
+	return application.WebhookRequest{
+		Requestor:           requestor,
+		RequestorIsOrgAdmin: isRequestorOrgAdmin(s.getIsOrgAdminFromGrpcContext(ctx), s.getRequestorOrgIDFromGrpcContext(ctx), orgID),
+		OrgID:               orgID,
+		WebhookID:           webhookID,
+	}, nil
+}
+
+func (s *Server) ensureWebhooksEnabled() error {
+	if s.WebhookAppService == nil {
+		return status.Error(codes.Unimplemented, "Webhooks are not enabled.")
+	}
+
+	return nil
+}
+
+func webhookToAPI(webhook domain.Webhook) *core.Webhook {
+	return &core.Webhook{Id: webhook.ID, ServiceId: webhook.ServiceID, Url: webhook.URL}
+}
+
+// ListAuditRecords returns a page of the audit records of the given org, oldest first
+func (s *Server) ListAuditRecords(ctx context.Context, grpcReq *core.ListAuditRecordsRequest) (*core.ListAuditRecordsResponse, error) {
+	if s.AuditAppService == nil {
+		return nil, status.Error(codes.Unimplemented, "Audit log is not queryable.")
+	}
+
+	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := application.ListAuditRecordsRequest{
+		Requestor:           requestor,
+		RequestorIsOrgAdmin: isRequestorOrgAdmin(s.getIsOrgAdminFromGrpcContext(ctx), s.getRequestorOrgIDFromGrpcContext(ctx), grpcReq.OrgId),
+		OrgID:               grpcReq.OrgId,
+		ServiceID:           grpcReq.ServiceId,
+		PageSize:            int(grpcReq.GetPageSize()),
+		PageToken:           grpcReq.GetPageToken(),
+	}
+	if grpcReq.From != nil {
+		req.From = grpcReq.From.AsTime()
+	}
+	if grpcReq.To != nil {
+		req.To = grpcReq.To.AsTime()
+	}
+
+	page, err := s.AuditAppService.ListAuditRecords(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &core.ListAuditRecordsResponse{Records: make([]*core.AuditRecord, len(page.Records)), NextPageToken: page.NextPageToken}
+	for i, record := range page.Records {
+		resp.Records[i] = auditRecordToAPI(record)
+	}
+
+	return resp, nil
+}
+
+func auditRecordToAPI(record domain.AuditRecord) *core.AuditRecord {
+	outcome := "success"
+	if !record.Succeeded() {
+		outcome = "failure"
+	}
+
+	return &core.AuditRecord{
+		Time:       timestamppb.New(record.Time),
+		Requestor:  string(record.Requestor),
+		Operation:  string(record.Operation),
+		ServiceId:  record.ServiceID,
+		Assigned:   subjectIDsToAPI(record.Assigned),
+		Unassigned: subjectIDsToAPI(record.Unassigned),
+		SubjectId:  string(record.SubjectID),
+		Detail:     record.Detail,
+		Outcome:    outcome,
+		Error:      record.Error,
+	}
+}
+
+func subjectIDsToAPI(ids []domain.SubjectID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = string(id)
+	}
+	return result
+}
+
 // ImportOrg imports users for a given orgID
 func (s *Server) ImportOrg(ctx context.Context, importReq *core.ImportOrgRequest) (*core.ImportOrgResponse, error) {
 	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
@@ -194,9 +605,10 @@ func (s *Server) ImportOrg(ctx context.Context, importReq *core.ImportOrgRequest
 
 	glog.Infof("Received request to import users for Org: %s from Requestor: %s", importReq.OrgId, requestor)
 	evt := application.ImportOrgEvent{
-		OrgID: importReq.OrgId,
+		OrgID:     importReq.OrgId,
+		Requestor: requestor,
 	}
-	result, e2 := s.LicenseAppService.ImportUsersForOrg(evt)
+	result, e2 := s.LicenseAppService.ImportUsersForOrg(ctx, evt)
 
 	if e2 != nil {
 		return nil, e2
@@ -208,11 +620,118 @@ func (s *Server) ImportOrg(ctx context.Context, importReq *core.ImportOrgRequest
 	}, nil
 }
 
+// ListDeadLetters returns all dead-lettered subject events, oldest first
+func (s *Server) ListDeadLetters(ctx context.Context, _ *core.ListDeadLettersRequest) (*core.ListDeadLettersResponse, error) {
+	if err := s.ensureRequestorCanManageDeadLetters(ctx); err != nil {
+		return nil, err
+	}
+
+	letters, err := s.DeadLetterAppService.ListDeadLetters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &core.ListDeadLettersResponse{DeadLetters: make([]*core.DeadLetter, len(letters))}
+	for i, letter := range letters {
+		resp.DeadLetters[i] = &core.DeadLetter{
+			Id:        letter.ID,
+			SubjectId: string(letter.SubjectID),
+			OrgId:     letter.OrgID,
+			Active:    letter.Active,
+			Deleted:   letter.Deleted,
+			Payload:   letter.Payload,
+			Reason:    letter.Reason,
+			Attempts:  int32(letter.Attempts),
+			Time:      timestamppb.New(letter.Time),
+		}
+	}
+
+	return resp, nil
+}
+
+// ReplayDeadLetter processes a dead-lettered subject event again and removes it if that succeeds
+func (s *Server) ReplayDeadLetter(ctx context.Context, grpcReq *core.ReplayDeadLetterRequest) (*core.ReplayDeadLetterResponse, error) {
+	if err := s.ensureRequestorCanManageDeadLetters(ctx); err != nil {
+		return nil, err
+	}
+
+	glog.Infof("Replaying dead letter %s", grpcReq.Id)
+	err := s.DeadLetterAppService.ReplayDeadLetter(ctx, application.DeadLetterRequest{ID: grpcReq.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.ReplayDeadLetterResponse{}, nil
+}
+
+// DeleteDeadLetter removes a dead letter without processing it
+func (s *Server) DeleteDeadLetter(ctx context.Context, grpcReq *core.DeleteDeadLetterRequest) (*core.DeleteDeadLetterResponse, error) {
+	if err := s.ensureRequestorCanManageDeadLetters(ctx); err != nil {
+		return nil, err
+	}
+
+	glog.Infof("Deleting dead letter %s", grpcReq.Id)
+	err := s.DeadLetterAppService.DeleteDeadLetter(ctx, application.DeadLetterRequest{ID: grpcReq.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.DeleteDeadLetterResponse{}, nil
+}
+
+func (s *Server) ensureRequestorCanManageDeadLetters(ctx context.Context) error {
+	if s.DeadLetterAppService == nil {
+		return status.Error(codes.Unimplemented, "Dead letters are only recorded if the UMB is enabled.")
+	}
+
+	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !sliceContains(s.ServiceConfig.AuthzConfig.DeadLetterAllowlist, requestor) {
+		glog.Infof("Received request to manage dead letters from Requestor: %s. Requestor not authorized.", requestor)
+		return domain.ErrNotAuthorized
+	}
+
+	return nil
+}
+
 // HealthCheck - heathcheck implementation returns 200 OK
 func (s *Server) HealthCheck(_ context.Context, _ *core.Empty) (*core.Empty, error) {
 	return &core.Empty{}, nil
 }
 
+// Liveness returns OK as long as the service is running, regardless of its dependencies
+func (s *Server) Liveness(_ context.Context, _ *core.Empty) (*core.Empty, error) {
+	return &core.Empty{}, nil
+}
+
+// Readiness probes the dependencies of the service and returns their status. If any is not available, the status is returned as detail of an Unavailable error.
+func (s *Server) Readiness(ctx context.Context, _ *core.Empty) (*core.ReadinessResponse, error) {
+	if s.HealthAppService == nil {
+		return &core.ReadinessResponse{Ready: true}, nil
+	}
+
+	result := s.HealthAppService.Readiness(ctx)
+
+	resp := &core.ReadinessResponse{Ready: result.Ready, Dependencies: make([]*core.DependencyStatus, len(result.Dependencies))}
+	for i, dependency := range result.Dependencies {
+		resp.Dependencies[i] = &core.DependencyStatus{Name: dependency.Name, Ready: dependency.Ready, Error: dependency.Error}
+	}
+
+	if !result.Ready {
+		glog.Warningf("Service not ready: %v", result.Dependencies)
+		st, err := status.New(codes.Unavailable, "Service not ready.").WithDetails(resp)
+		if err != nil {
+			return nil, err
+		}
+		return nil, st.Err()
+	}
+
+	return resp, nil
+}
+
 func sliceContains(s []string, str string) bool {
 	for _, v := range s {
 		if v == str {
@@ -270,13 +789,17 @@ func (s *Server) Serve(wait *sync.WaitGroup) error {
 		glog.Warning("Client authorization disabled. Do not use in production use cases!")
 		authnhandler = authMiddleware.Unary()
 	}
+	metricsHandler := interceptor.NewMetricsInterceptor().Unary()
 	errorHandler := interceptor.NewErrorConvertingInterceptor().Unary()
-	s.srv = grpc.NewServer(grpc.Creds(creds), grpc.ChainUnaryInterceptor(authnhandler, errorHandler))
+	s.srv = grpc.NewServer(grpc.Creds(creds), grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(metricsHandler, authnhandler, errorHandler))
 
 	core.RegisterHealthCheckServiceServer(s.srv, s)
 	core.RegisterCheckPermissionServer(s.srv, s)
 	core.RegisterLicenseServiceServer(s.srv, s)
 	core.RegisterImportServiceServer(s.srv, s)
+	core.RegisterDeadLetterServiceServer(s.srv, s)
+	healthpb.RegisterHealthServer(s.srv, &healthServer{s})
 
 	err = s.srv.Serve(ls)
 	if err != nil {
@@ -326,7 +849,7 @@ func (s *Server) CheckPermission(ctx context.Context, rpcReq *core.CheckPermissi
 		ResourceID:   rpcReq.Resourceid,
 	}
 
-	result, err := s.AccessAppService.Check(req)
+	result, err := s.AccessAppService.Check(ctx, req)
 
 	if err != nil {
 		return nil, err
@@ -335,6 +858,48 @@ func (s *Server) CheckPermission(ctx context.Context, rpcReq *core.CheckPermissi
 	return &core.CheckPermissionResponse{Result: bool(result)}, nil
 }
 
+// CheckPermissions evaluates a batch of checks, returning an error per check instead of failing the whole batch
+func (s *Server) CheckPermissions(ctx context.Context, rpcReq *core.CheckPermissionsRequest) (*core.CheckPermissionsResponse, error) {
+	requestor, err := s.getRequestorIdentityFromGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !sliceContains(s.ServiceConfig.AuthzConfig.CheckAllowList, requestor) {
+		glog.Infof("Received CheckPermissions from Requestor: %s. Requestor not authorized. Request: %v", requestor, rpcReq)
+		return nil, domain.ErrNotAuthorized
+	}
+
+	req := application.CheckBatchRequest{
+		Requestor: requestor,
+		Checks:    make([]application.CheckRequest, len(rpcReq.Checks)),
+	}
+	for i, check := range rpcReq.Checks {
+		req.Checks[i] = application.CheckRequest{
+			Subject:      check.Subject,
+			Operation:    check.Operation,
+			ResourceType: check.Resourcetype,
+			ResourceID:   check.Resourceid,
+		}
+	}
+
+	results, err := s.AccessAppService.CheckBatch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &core.CheckPermissionsResponse{Results: make([]*core.CheckPermissionsResult, len(results))}
+	for i, result := range results {
+		resp.Results[i] = &core.CheckPermissionsResult{Result: bool(result.Decision)}
+		if result.Err != nil {
+			resp.Results[i].Result = false
+			resp.Results[i].Error = status.Convert(interceptor.ConvertDomainErrorToGrpc(result.Err)).Message()
+		}
+	}
+
+	return resp, nil
+}
+
 func (s *Server) getRequestorIdentityFromGrpcContext(ctx context.Context) (string, error) {
 	requestor := ctx.Value(interceptor.RequestorContextKey)
 	reqStr := requestor.(string)
@@ -144,7 +144,7 @@ func getProviderData(discoveryEndpoint string) (data providerJSON, err error) {
 func (authnInterceptor *AuthnInterceptor) Unary() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 
-		if info.FullMethod == "/api.v1alpha.HealthCheckService/HealthCheck" {
+		if isHealthCheck(info.FullMethod) {
 			return handler(ctx, req)
 		}
 		token := getBearerTokenFromContext(ctx)
@@ -270,3 +270,8 @@ func requestorOrg(token jwt.Token) (orgID string, isOrgAdmin bool) {
 	}
 	return orgID, isOrgAdmin
 }
+
+// isHealthCheck returns true for the methods of the health services, which are probed without a token
+func isHealthCheck(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/api.v1alpha.HealthCheckService/") || strings.HasPrefix(fullMethod, "/grpc.health.v1.Health/")
+}
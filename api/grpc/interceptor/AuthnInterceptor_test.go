@@ -415,3 +415,10 @@ func TestMain(m *testing.M) {
 	result := m.Run()
 	os.Exit(result)
 }
+
+func TestHealthChecksDoNotRequireToken(t *testing.T) {
+	assert.True(t, isHealthCheck("/api.v1alpha.HealthCheckService/HealthCheck"))
+	assert.True(t, isHealthCheck("/api.v1alpha.HealthCheckService/Readiness"))
+	assert.True(t, isHealthCheck("/grpc.health.v1.Health/Check"))
+	assert.False(t, isHealthCheck("/api.v1alpha.LicenseService/GetLicense"))
+}
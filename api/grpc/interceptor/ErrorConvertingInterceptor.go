@@ -25,13 +25,14 @@ func (i *ErrorConvertingInterceptor) Unary() grpc.UnaryServerInterceptor {
 		resp, err = handler(ctx, req)
 
 		if err != nil {
-			err = convertDomainErrorToGrpc(err)
+			err = ConvertDomainErrorToGrpc(err)
 		}
 		return
 	}
 }
 
-func convertDomainErrorToGrpc(err error) error {
+// ConvertDomainErrorToGrpc converts a domain error to a gRPC status error. gRPC errors are returned unchanged.
+func ConvertDomainErrorToGrpc(err error) error {
 	var validationErr domain.ErrInvalidRequest
 
 	_, ok := status.FromError(err) //if already gRPC error, don't convert
@@ -49,6 +50,14 @@ func convertDomainErrorToGrpc(err error) error {
 		return status.Error(codes.FailedPrecondition, "License limits exceeded.")
 	case errors.Is(err, domain.ErrConflict):
 		return status.Error(codes.FailedPrecondition, "Conflict")
+	case errors.Is(err, domain.ErrLicenseNotFound):
+		return status.Error(codes.NotFound, "License not found.")
+	case errors.Is(err, domain.ErrWebhookNotFound):
+		return status.Error(codes.NotFound, "Webhook not found.")
+	case errors.Is(err, domain.ErrDeadLetterNotFound):
+		return status.Error(codes.NotFound, "Dead letter not found.")
+	case errors.Is(err, domain.ErrAuditLogNotQueryable):
+		return status.Error(codes.Unimplemented, "Audit log is not queryable.")
 	case errors.As(err, &validationErr):
 		glog.Errorf("Validation error: %s", validationErr.Reason)
 		return status.Error(codes.InvalidArgument, validationErr.Reason)
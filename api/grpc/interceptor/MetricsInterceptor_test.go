@@ -0,0 +1,32 @@
+package interceptor
+
+import (
+	"authz/infrastructure/metrics"
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetricsInterceptorCountsRequestsByCode(t *testing.T) {
+	const method = "/api.v1alpha.LicenseService/MetricsTest"
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	unary := NewMetricsInterceptor().Unary()
+
+	_, err := unary(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	assert.NoError(t, err)
+
+	_, err = unary(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "License not found.")
+	})
+	assert.Error(t, err)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.GrpcRequests.WithLabelValues(method, codes.OK.String())))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.GrpcRequests.WithLabelValues(method, codes.NotFound.String())))
+}
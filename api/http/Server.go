@@ -5,6 +5,7 @@ import (
 	core "authz/api/gen/v1alpha"
 	"authz/bootstrap/serviceconfig"
 	"authz/infrastructure/grpcutil"
+	"authz/infrastructure/metrics"
 	"context"
 	"errors"
 	"net/http"
@@ -16,6 +17,8 @@ import (
 	"github.com/golang/glog"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/rs/cors"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
 )
 
@@ -116,6 +119,9 @@ func createMultiplexer(cnf *serviceconfig.ServiceConfig) (http.Handler, error) {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
 
+	// Propagates the trace of the HTTP request to the gRPC server
+	opts = append(opts, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+
 	if err := core.RegisterCheckPermissionHandlerFromEndpoint(context.Background(), mux, "localhost:"+cnf.GrpcPortStr, opts); err != nil {
 		return nil, err
 	}
@@ -128,11 +134,21 @@ func createMultiplexer(cnf *serviceconfig.ServiceConfig) (http.Handler, error) {
 		return nil, err
 	}
 
+	if err := core.RegisterDeadLetterServiceHandlerFromEndpoint(context.Background(), mux, "localhost:"+cnf.GrpcPortStr, opts); err != nil {
+		return nil, err
+	}
+
 	if err := core.RegisterHealthCheckServiceHandlerFromEndpoint(context.Background(), mux, "localhost:"+cnf.GrpcPortStr, opts); err != nil {
 		return nil, err
 	}
 
-	chain := createChain(logMiddleware(*cnf), corsMiddleware(*cnf)).then(mux)
+	if err := mux.HandlePath(http.MethodGet, "/metrics", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		metrics.Handler().ServeHTTP(w, r)
+	}); err != nil {
+		return nil, err
+	}
+
+	chain := createChain(tracingMiddleware(), logMiddleware(*cnf), corsMiddleware(*cnf)).then(mux)
 
 	return chain, nil
 }
@@ -150,6 +166,12 @@ func corsMiddleware(c serviceconfig.ServiceConfig) middleware {
 	}
 }
 
+func tracingMiddleware() middleware {
+	return otelhttp.NewMiddleware("gateway", otelhttp.WithFilter(func(r *http.Request) bool {
+		return r.URL.Path != "/metrics"
+	}))
+}
+
 func logMiddleware(c serviceconfig.ServiceConfig) middleware {
 	return func(h http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
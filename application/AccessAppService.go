@@ -6,13 +6,16 @@ import (
 	"authz/domain/contracts"
 	"authz/domain/services"
 	"context"
+	"sync"
 )
 
+// maxConcurrentChecks limits how many checks of a batch are evaluated at the same time
+const maxConcurrentChecks = 10
+
 // AccessAppService the handler for permission related endpoints.
 type AccessAppService struct {
 	accessRepo    *contracts.AccessRepository
 	principalRepo contracts.PrincipalRepository
-	ctx           context.Context
 }
 
 // CheckRequest is an actual request to check for permissions.
@@ -24,17 +27,28 @@ type CheckRequest struct {
 	Operation    string `validate:"required,in=access"`
 }
 
+// CheckBatchRequest is a request to check several permissions at once. Each check is validated on its own.
+type CheckBatchRequest struct {
+	Requestor string         `validate:"required"`
+	Checks    []CheckRequest `validate:"required,min=1,max=100"`
+}
+
+// CheckResult is the outcome of a single check of a batch. Err is set if the check could not be evaluated.
+type CheckResult struct {
+	Decision domain.AccessDecision
+	Err      error
+}
+
 // NewAccessAppService returns a new instance of the permissionhandler.
 func NewAccessAppService(accessRepo *contracts.AccessRepository, principalRepo contracts.PrincipalRepository) *AccessAppService {
 	return &AccessAppService{
 		accessRepo:    accessRepo,
 		principalRepo: principalRepo,
-		ctx:           context.Background(),
 	}
 }
 
 // Check calls the domainservice using a CheckEvent and can be used with every server impl if wanted.
-func (p *AccessAppService) Check(req CheckRequest) (domain.AccessDecision, error) {
+func (p *AccessAppService) Check(ctx context.Context, req CheckRequest) (domain.AccessDecision, error) {
 	err := ValidateStruct(req)
 	if err != nil {
 		return false, err
@@ -50,5 +64,35 @@ func (p *AccessAppService) Check(req CheckRequest) (domain.AccessDecision, error
 
 	checkResult := services.NewAccessService(*p.accessRepo)
 
-	return checkResult.Check(event)
+	return checkResult.Check(ctx, event)
+}
+
+// CheckBatch evaluates the checks of the request concurrently and returns their results in the same order. An error is only returned if the batch itself is invalid.
+func (p *AccessAppService) CheckBatch(ctx context.Context, req CheckBatchRequest) ([]CheckResult, error) {
+	err := ValidateStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]CheckResult, len(req.Checks))
+	limit := make(chan struct{}, maxConcurrentChecks)
+	var wg sync.WaitGroup
+
+	for i, check := range req.Checks {
+		check.Requestor = req.Requestor
+
+		wg.Add(1)
+		limit <- struct{}{}
+		go func(i int, check CheckRequest) {
+			defer func() {
+				<-limit
+				wg.Done()
+			}()
+
+			results[i].Decision, results[i].Err = p.Check(ctx, check)
+		}(i, check)
+	}
+
+	wg.Wait()
+	return results, nil
 }
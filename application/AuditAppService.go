@@ -0,0 +1,66 @@
+package application
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"authz/domain/services"
+	"context"
+	"time"
+)
+
+// AuditAppService the handler for reading the audit log of license mutations
+type AuditAppService struct {
+	accessRepo contracts.AccessRepository
+	auditLog   contracts.AuditLog
+}
+
+// ListAuditRecordsRequest represents a request to get the audit records of an organization
+type ListAuditRecordsRequest struct {
+	Requestor           string `validate:"required"`
+	RequestorIsOrgAdmin bool
+	OrgID               string    `validate:"required,identifier"`
+	ServiceID           string    `validate:"omitempty,service"` // optional, only records about the license of this service
+	From                time.Time // optional, only records at or after this time
+	To                  time.Time `validate:"omitempty,gtfield=From"` // optional, only records before this time
+	PageSize            int       `validate:"gte=0,lte=1000"`         // optional, defaults to defaultAuditPageSize
+	PageToken           string    `validate:"omitempty,max=1024"`     // optional, continues after the page that returned this token
+}
+
+// defaultAuditPageSize is the number of records returned per page unless requested otherwise
+const defaultAuditPageSize = 100
+
+// NewAuditAppService creates a new AuditAppService
+func NewAuditAppService(accessRepo contracts.AccessRepository, auditLog contracts.AuditLog) *AuditAppService {
+	return &AuditAppService{
+		accessRepo: accessRepo,
+		auditLog:   auditLog,
+	}
+}
+
+// ListAuditRecords gets a page of the audit records of an organization, oldest first
+func (s *AuditAppService) ListAuditRecords(ctx context.Context, req ListAuditRecordsRequest) (page domain.AuditPage, err error) {
+	ctx, span := startSpan(ctx, "AuditAppService.ListAuditRecords", orgAttr(req.OrgID), serviceAttr(req.ServiceID))
+	defer endSpan(span, &err)
+
+	if err = ValidateStruct(req); err != nil {
+		return domain.AuditPage{}, err
+	}
+
+	limit := req.PageSize
+	if limit == 0 {
+		limit = defaultAuditPageSize
+	}
+
+	evt := domain.AuditEvent{Query: domain.AuditQuery{
+		OrgID:     req.OrgID,
+		ServiceID: req.ServiceID,
+		From:      req.From,
+		To:        req.To,
+		Limit:     limit,
+		PageToken: req.PageToken,
+	}}
+	evt.Requestor = domain.SubjectID(req.Requestor)
+	evt.RequestorIsOrgAdmin = req.RequestorIsOrgAdmin
+
+	return services.NewAuditService(s.auditLog, s.accessRepo).GetRecords(ctx, evt)
+}
@@ -0,0 +1,175 @@
+package application
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"authz/infrastructure/repository/audit"
+	"authz/infrastructure/repository/memory"
+	"authz/infrastructure/repository/mock"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditRecordsSeatModificationsWithRequestorAndOutcome(t *testing.T) {
+	licenses, audits := createAuditedServices(t)
+
+	err := licenses.ModifySeats(context.Background(), ModifySeatAssignmentRequest{Requestor: "okay", OrgID: "o1", ServiceID: "smarts", Assign: []string{"u2"}, Unassign: []string{"u1"}})
+	assert.NoError(t, err)
+	err = licenses.ModifySeats(context.Background(), ModifySeatAssignmentRequest{Requestor: "u2", OrgID: "o1", ServiceID: "smarts", Unassign: []string{"u2"}})
+	assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+	page, err := audits.ListAuditRecords(context.Background(), ListAuditRecordsRequest{Requestor: "okay", OrgID: "o1"})
+	records := page.Records
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+
+	assert.Equal(t, domain.SubjectID("okay"), records[0].Requestor)
+	assert.Equal(t, domain.AuditModifySeats, records[0].Operation)
+	assert.Equal(t, "smarts", records[0].ServiceID)
+	assert.Equal(t, []domain.SubjectID{"u2"}, records[0].Assigned)
+	assert.Equal(t, []domain.SubjectID{"u1"}, records[0].Unassigned)
+	assert.True(t, records[0].Succeeded())
+	assert.False(t, records[0].Time.IsZero())
+
+	assert.Equal(t, domain.SubjectID("u2"), records[1].Requestor)
+	assert.False(t, records[1].Succeeded())
+	assert.Equal(t, domain.ErrNotAuthorized.Error(), records[1].Error)
+}
+
+func TestAuditRecordsEntitlementsImportsAndSubjectEvents(t *testing.T) {
+	licenses, audits := createAuditedServices(t)
+
+	err := licenses.HandleOrgEntitledEvent(context.Background(), OrgEntitledEvent{OrgID: "o2", ServiceID: "smarts", MaxSeats: 5, Requestor: "system"})
+	assert.NoError(t, err)
+	_, err = licenses.ImportUsersForOrg(context.Background(), ImportOrgEvent{OrgID: "o2", Requestor: "system"})
+	assert.NoError(t, err)
+	err = licenses.HandleSubjectAddOrUpdateEvent(context.Background(), contracts.SubjectAddOrUpdateEvent{SubjectID: "u9", OrgID: "o2", Active: true})
+	assert.NoError(t, err)
+
+	page, err := audits.ListAuditRecords(context.Background(), ListAuditRecordsRequest{Requestor: "admin", RequestorIsOrgAdmin: true, OrgID: "o2"})
+	records := page.Records
+	assert.NoError(t, err)
+	assert.Len(t, records, 3)
+
+	assert.Equal(t, domain.AuditEntitleOrg, records[0].Operation)
+	assert.Equal(t, domain.SubjectID("system"), records[0].Requestor)
+	assert.Equal(t, "maxSeats=5", records[0].Detail)
+	assert.Equal(t, domain.AuditImportOrg, records[1].Operation)
+	assert.Equal(t, "imported=0 notImported=0", records[1].Detail)
+	assert.Equal(t, domain.AuditSubjectEvent, records[2].Operation)
+	assert.Equal(t, domain.SubjectID("u9"), records[2].SubjectID)
+	assert.Empty(t, records[2].Requestor)
+}
+
+func TestAuditRecordsEntitlementUpdatesWithRequestorAndOutcome(t *testing.T) {
+	licenses, audits := createAuditedServices(t)
+
+	_, err := licenses.HandleEntitlementUpdatedEvent(context.Background(), EntitlementUpdatedEvent{OrgID: "o1", ServiceID: "smarts", MaxSeats: 20, DowngradePolicy: "reject", Requestor: "system"})
+	assert.NoError(t, err)
+	_, err = licenses.HandleEntitlementUpdatedEvent(context.Background(), EntitlementUpdatedEvent{OrgID: "o1", ServiceID: "smarts", MaxSeats: 1, DowngradePolicy: "reject", Requestor: "system"})
+	assert.Error(t, err)
+
+	page, err := audits.ListAuditRecords(context.Background(), ListAuditRecordsRequest{Requestor: "okay", OrgID: "o1", ServiceID: "smarts"})
+	records := page.Records
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+
+	assert.Equal(t, domain.AuditUpdateEntitlement, records[0].Operation)
+	assert.Equal(t, domain.SubjectID("system"), records[0].Requestor)
+	assert.Equal(t, "maxSeats=20 downgradePolicy=reject overage=0", records[0].Detail)
+	assert.True(t, records[0].Succeeded())
+
+	assert.Equal(t, "maxSeats=1 downgradePolicy=reject", records[1].Detail)
+	assert.False(t, records[1].Succeeded())
+}
+
+func TestAuditRecordsEntitlementRevocationsWithRequestor(t *testing.T) {
+	licenses, audits := createAuditedServices(t)
+
+	err := licenses.HandleEntitlementRevokedEvent(context.Background(), EntitlementRevokedEvent{OrgID: "o1", ServiceID: "smarts", Requestor: "system"})
+	assert.NoError(t, err)
+	err = licenses.HandleEntitlementRevokedEvent(context.Background(), EntitlementRevokedEvent{OrgID: "o1", ServiceID: "smarts", Requestor: "system"})
+	assert.ErrorIs(t, err, domain.ErrLicenseNotFound)
+
+	page, err := audits.ListAuditRecords(context.Background(), ListAuditRecordsRequest{Requestor: "admin", RequestorIsOrgAdmin: true, OrgID: "o1"})
+	records := page.Records
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+
+	assert.Equal(t, domain.AuditRevokeEntitlement, records[0].Operation)
+	assert.Equal(t, domain.SubjectID("system"), records[0].Requestor)
+	assert.True(t, records[0].Succeeded())
+	assert.Equal(t, domain.ErrLicenseNotFound.Error(), records[1].Error)
+}
+
+func TestAuditRecordsAreFilteredByServiceAndTime(t *testing.T) {
+	licenses, audits := createAuditedServices(t)
+	assert.NoError(t, licenses.ModifySeats(context.Background(), ModifySeatAssignmentRequest{Requestor: "okay", OrgID: "o1", ServiceID: "smarts", Assign: []string{"u2"}}))
+	_, err := licenses.ImportUsersForOrg(context.Background(), ImportOrgEvent{OrgID: "o1"})
+	assert.NoError(t, err)
+
+	page, err := audits.ListAuditRecords(context.Background(), ListAuditRecordsRequest{Requestor: "okay", OrgID: "o1", ServiceID: "smarts"})
+	records := page.Records
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, domain.AuditModifySeats, records[0].Operation)
+
+	page, err = audits.ListAuditRecords(context.Background(), ListAuditRecordsRequest{Requestor: "okay", OrgID: "o1", From: records[0].Time.Add(1)})
+	records = page.Records
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, domain.AuditImportOrg, records[0].Operation)
+
+	page, err = audits.ListAuditRecords(context.Background(), ListAuditRecordsRequest{Requestor: "okay", OrgID: "o1", To: records[0].Time})
+	records = page.Records
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, domain.AuditModifySeats, records[0].Operation)
+}
+
+func TestAuditRecordsCannotBeReadByOrdinaryUser(t *testing.T) {
+	_, audits := createAuditedServices(t)
+
+	_, err := audits.ListAuditRecords(context.Background(), ListAuditRecordsRequest{Requestor: "u2", OrgID: "o1"})
+	assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+	_, err = audits.ListAuditRecords(context.Background(), ListAuditRecordsRequest{Requestor: "u2", OrgID: "o1", ServiceID: "smarts"})
+	assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+}
+
+func TestAuditRecordsAreReturnedInPages(t *testing.T) {
+	licenses, audits := createAuditedServices(t)
+	assert.NoError(t, licenses.ModifySeats(context.Background(), ModifySeatAssignmentRequest{Requestor: "okay", OrgID: "o1", ServiceID: "smarts", Assign: []string{"u2"}}))
+	assert.NoError(t, licenses.ModifySeats(context.Background(), ModifySeatAssignmentRequest{Requestor: "okay", OrgID: "o1", ServiceID: "smarts", Unassign: []string{"u2"}}))
+	assert.NoError(t, licenses.ModifySeats(context.Background(), ModifySeatAssignmentRequest{Requestor: "okay", OrgID: "o1", ServiceID: "smarts", Unassign: []string{"u1"}}))
+
+	page, err := audits.ListAuditRecords(context.Background(), ListAuditRecordsRequest{Requestor: "okay", OrgID: "o1", PageSize: 2})
+	assert.NoError(t, err)
+	assert.Len(t, page.Records, 2)
+	assert.Equal(t, []domain.SubjectID{"u2"}, page.Records[0].Assigned)
+	assert.Equal(t, []domain.SubjectID{"u2"}, page.Records[1].Unassigned)
+	assert.NotEmpty(t, page.NextPageToken)
+
+	page, err = audits.ListAuditRecords(context.Background(), ListAuditRecordsRequest{Requestor: "okay", OrgID: "o1", PageSize: 2, PageToken: page.NextPageToken})
+	assert.NoError(t, err)
+	assert.Len(t, page.Records, 1)
+	assert.Equal(t, []domain.SubjectID{"u1"}, page.Records[0].Unassigned)
+	assert.Empty(t, page.NextPageToken)
+
+	_, err = audits.ListAuditRecords(context.Background(), ListAuditRecordsRequest{Requestor: "okay", OrgID: "o1", PageToken: "not a token"})
+	assert.ErrorAs(t, err, &domain.ErrInvalidRequest{})
+}
+
+func createAuditedServices(t *testing.T) (*LicenseAppService, *AuditAppService) {
+	repo, err := memory.NewSeededInMemoryAccessRepository()
+	assert.NoError(t, err)
+	principals := &mock.StubPrincipalRepository{}
+	log, err := audit.NewFileAuditLog(filepath.Join(t.TempDir(), "audit.jsonl"))
+	assert.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, log.Close()) })
+
+	return NewLicenseAppService(repo, repo, principals, principals, repo, log), NewAuditAppService(repo, log)
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"context"
+
+	"github.com/golang/glog"
+)
+
+// DeadLetterAppService the handler for inspecting and replaying subject events that could not be processed
+type DeadLetterAppService struct {
+	deadLetterRepo    contracts.DeadLetterRepository
+	licenseAppService *LicenseAppService
+}
+
+// DeadLetterRequest represents a request to replay or remove a dead letter
+type DeadLetterRequest struct {
+	ID string `validate:"required,identifier"`
+}
+
+// NewDeadLetterAppService creates a new DeadLetterAppService. Replayed events are processed by the given LicenseAppService.
+func NewDeadLetterAppService(deadLetterRepo contracts.DeadLetterRepository, licenseAppService *LicenseAppService) *DeadLetterAppService {
+	return &DeadLetterAppService{
+		deadLetterRepo:    deadLetterRepo,
+		licenseAppService: licenseAppService,
+	}
+}
+
+// ListDeadLetters gets all dead letters, oldest first
+func (s *DeadLetterAppService) ListDeadLetters(ctx context.Context) ([]domain.DeadLetter, error) {
+	return s.deadLetterRepo.GetDeadLetters(ctx)
+}
+
+// ReplayDeadLetter processes the event of a dead letter again and removes the dead letter if that succeeds
+func (s *DeadLetterAppService) ReplayDeadLetter(ctx context.Context, req DeadLetterRequest) error {
+	if err := ValidateStruct(req); err != nil {
+		return err
+	}
+
+	letter, err := s.deadLetterRepo.GetDeadLetter(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	if !letter.Replayable() {
+		return domain.NewErrInvalidRequest("Dead letter " + req.ID + " contains no event that can be replayed.")
+	}
+
+	err = s.licenseAppService.HandleSubjectAddOrUpdateEvent(ctx, contracts.SubjectAddOrUpdateEvent{
+		SubjectID: string(letter.SubjectID),
+		OrgID:     letter.OrgID,
+		Active:    letter.Active,
+		Deleted:   letter.Deleted,
+	})
+	if err != nil {
+		glog.Errorf("Error replaying dead letter %s: %v", req.ID, err)
+		return err
+	}
+
+	return s.deadLetterRepo.RemoveDeadLetter(ctx, req.ID)
+}
+
+// DeleteDeadLetter removes a dead letter without processing it
+func (s *DeadLetterAppService) DeleteDeadLetter(ctx context.Context, req DeadLetterRequest) error {
+	if err := ValidateStruct(req); err != nil {
+		return err
+	}
+
+	return s.deadLetterRepo.RemoveDeadLetter(ctx, req.ID)
+}
@@ -0,0 +1,64 @@
+package application
+
+import (
+	"authz/domain"
+	"authz/infrastructure/repository/memory"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplayedDeadLetterIsProcessedAndRemoved(t *testing.T) {
+	service, repo, deadLetters := createDeadLetterService(t)
+	letter := addDeadLetter(t, deadLetters, domain.DeadLetter{SubjectID: "new_user", OrgID: "o1", Active: true, Reason: "unavailable", Attempts: 5})
+
+	err := service.ReplayDeadLetter(context.Background(), DeadLetterRequest{ID: letter.ID})
+	assert.NoError(t, err)
+
+	assignable, err := repo.GetAssignable(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Contains(t, assignable, domain.SubjectID("new_user"))
+
+	letters, err := service.ListDeadLetters(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, letters)
+}
+
+func TestUnparseableDeadLetterCannotBeReplayedButDeleted(t *testing.T) {
+	service, _, deadLetters := createDeadLetterService(t)
+	letter := addDeadLetter(t, deadLetters, domain.DeadLetter{Payload: "<garbage", Reason: "XML syntax error", Attempts: 1})
+
+	err := service.ReplayDeadLetter(context.Background(), DeadLetterRequest{ID: letter.ID})
+	assert.ErrorAs(t, err, &domain.ErrInvalidRequest{})
+
+	letters, err := service.ListDeadLetters(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.DeadLetter{letter}, letters)
+
+	assert.NoError(t, service.DeleteDeadLetter(context.Background(), DeadLetterRequest{ID: letter.ID}))
+	err = service.DeleteDeadLetter(context.Background(), DeadLetterRequest{ID: letter.ID})
+	assert.ErrorIs(t, err, domain.ErrDeadLetterNotFound)
+}
+
+func TestReplayingUnknownDeadLetterFails(t *testing.T) {
+	service, _, _ := createDeadLetterService(t)
+
+	err := service.ReplayDeadLetter(context.Background(), DeadLetterRequest{ID: "unknown"})
+	assert.ErrorIs(t, err, domain.ErrDeadLetterNotFound)
+}
+
+func createDeadLetterService(t *testing.T) (*DeadLetterAppService, *memory.InMemoryAccessRepository, *memory.InMemoryDeadLetterRepository) {
+	licenses, repo := createInMemoryService(t)
+	deadLetters := memory.NewInMemoryDeadLetterRepository()
+
+	return NewDeadLetterAppService(deadLetters, licenses), repo, deadLetters
+}
+
+func addDeadLetter(t *testing.T, deadLetters *memory.InMemoryDeadLetterRepository, letter domain.DeadLetter) domain.DeadLetter {
+	letter.Time = time.Now().UTC()
+	letter.ID = domain.NewDeadLetterID(letter.Time)
+	assert.NoError(t, deadLetters.AddDeadLetter(context.Background(), letter))
+	return letter
+}
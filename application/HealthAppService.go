@@ -0,0 +1,75 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// probeTimeout limits how long a single dependency may take to answer a readiness probe
+const probeTimeout = 3 * time.Second
+
+// HealthAppService the handler for the readiness of the service, which depends on the availability of its dependencies
+type HealthAppService struct {
+	probes []DependencyProbe
+}
+
+// Pinger is implemented by repositories that can check the availability of the dependency they access
+type Pinger interface {
+	// Ping returns an error if the dependency is not available
+	Ping(ctx context.Context) error
+}
+
+// DependencyProbe checks whether a dependency of the service is available
+type DependencyProbe struct {
+	Name  string
+	Probe func(ctx context.Context) error
+}
+
+// DependencyStatus is the outcome of probing a dependency
+type DependencyStatus struct {
+	Name  string
+	Ready bool
+	Error string // empty if the dependency is ready
+}
+
+// ReadinessResult represents the readiness of the service and the status of each of its dependencies
+type ReadinessResult struct {
+	Ready        bool
+	Dependencies []DependencyStatus // in the order of the probes
+}
+
+// NewHealthAppService creates a new HealthAppService probing the given dependencies
+func NewHealthAppService(probes ...DependencyProbe) *HealthAppService {
+	return &HealthAppService{probes: probes}
+}
+
+// Readiness probes all dependencies concurrently. The service is ready if all dependencies are.
+func (s *HealthAppService) Readiness(ctx context.Context) ReadinessResult {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	result := ReadinessResult{Ready: true, Dependencies: make([]DependencyStatus, len(s.probes))}
+
+	wg := sync.WaitGroup{}
+	for i, probe := range s.probes {
+		wg.Add(1)
+		go func(i int, probe DependencyProbe) {
+			defer wg.Done()
+
+			status := DependencyStatus{Name: probe.Name, Ready: true}
+			if err := probe.Probe(ctx); err != nil {
+				status.Ready = false
+				status.Error = err.Error()
+			}
+			result.Dependencies[i] = status
+		}(i, probe)
+	}
+	wg.Wait()
+
+	for _, status := range result.Dependencies {
+		result.Ready = result.Ready && status.Ready
+	}
+
+	return result
+}
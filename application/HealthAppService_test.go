@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceWithoutDependenciesIsReady(t *testing.T) {
+	result := NewHealthAppService().Readiness(context.Background())
+
+	assert.True(t, result.Ready)
+	assert.Empty(t, result.Dependencies)
+}
+
+func TestServiceIsReadyIfAllDependenciesAre(t *testing.T) {
+	service := NewHealthAppService(
+		DependencyProbe{Name: "spicedb", Probe: func(context.Context) error { return nil }},
+		DependencyProbe{Name: "umb", Probe: func(context.Context) error { return nil }},
+	)
+
+	result := service.Readiness(context.Background())
+
+	assert.True(t, result.Ready)
+	assert.Equal(t, []DependencyStatus{{Name: "spicedb", Ready: true}, {Name: "umb", Ready: true}}, result.Dependencies)
+}
+
+func TestServiceIsNotReadyIfAnyDependencyIsUnavailable(t *testing.T) {
+	service := NewHealthAppService(
+		DependencyProbe{Name: "spicedb", Probe: func(context.Context) error { return errors.New("connection refused") }},
+		DependencyProbe{Name: "userservice", Probe: func(context.Context) error { return nil }},
+	)
+
+	result := service.Readiness(context.Background())
+
+	assert.False(t, result.Ready)
+	assert.Equal(t, []DependencyStatus{{Name: "spicedb", Error: "connection refused"}, {Name: "userservice", Ready: true}}, result.Dependencies)
+}
+
+func TestReadinessProbesAreCanceledWithTheRequest(t *testing.T) {
+	service := NewHealthAppService(DependencyProbe{Name: "spicedb", Probe: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := service.Readiness(ctx)
+
+	assert.False(t, result.Ready)
+	assert.Equal(t, context.Canceled.Error(), result.Dependencies[0].Error)
+}
@@ -5,9 +5,15 @@ import (
 	"authz/domain/contracts"
 	"authz/domain/services"
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
 	"sync/atomic"
+	"time"
 
 	"github.com/golang/glog"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // LicenseAppService the handler for seat related endpoints.
@@ -17,44 +23,117 @@ type LicenseAppService struct {
 	principalRepo contracts.PrincipalRepository
 	subjectRepo   contracts.SubjectRepository
 	orgRepo       contracts.OrganizationRepository
-	ctx           context.Context
+	auditLog      contracts.AuditLog // nil if license mutations are not audited
 }
 
 // GetSeatAssignmentRequest represents a request to get the users assigned seats on a license
 type GetSeatAssignmentRequest struct {
-	Requestor    string `validate:"required"`
-	OrgID        string `validate:"required,identifier"`
-	ServiceID    string `validate:"required,service"`
-	IncludeUsers bool
-	Assigned     bool
+	Requestor           string `validate:"required"`
+	RequestorIsOrgAdmin bool   // true if the identity provider asserts that the requestor administrates the requested organization
+	OrgID               string `validate:"required,identifier"`
+	ServiceID           string `validate:"required,service"`
+	IncludeUsers        bool
+	Assigned            bool
+	PageSize            int    `validate:"gte=0,lte=1000"`                       // optional, zero returns all seats
+	PageToken           string `validate:"omitempty,max=1024"`                   // optional, continues after the page that returned this token
+	SortBy              string `validate:"omitempty,in=id+username+displayName"` // optional, defaults to id
+	Search              string `validate:"max=100"`                              // optional, only include users whose name or username contains this term
+}
+
+// GetSeatAssignmentsResult contains a page of subjects and the token to request the next page, which is empty on the last page
+type GetSeatAssignmentsResult struct {
+	Principals    []domain.Principal
+	NextPageToken string
 }
 
 // ModifySeatAssignmentRequest represents a request to assign and/or unassign seat licenses
 type ModifySeatAssignmentRequest struct {
-	Requestor string   `validate:"required"`
-	OrgID     string   `validate:"required,identifier"`
-	ServiceID string   `validate:"required,service"`
-	Assign    []string `validate:"unique,dive,required,identifier"`
-	Unassign  []string `validate:"unique,dive,required,identifier"`
+	Requestor           string `validate:"required"`
+	RequestorIsOrgAdmin bool
+	OrgID               string   `validate:"required,identifier"`
+	ServiceID           string   `validate:"required,service"`
+	Assign              []string `validate:"unique,dive,required,identifier"`
+	Unassign            []string `validate:"unique,dive,required,identifier"`
 }
 
 // GetSeatAssignmentCountsRequest represents a request to get the seats limit and current allocation for a license
 type GetSeatAssignmentCountsRequest struct {
-	Requestor string `validate:"required"`
-	OrgID     string `validate:"required,identifier"`
-	ServiceID string `validate:"required,service"`
+	Requestor           string `validate:"required"`
+	RequestorIsOrgAdmin bool
+	OrgID               string `validate:"required,identifier"`
+	ServiceID           string `validate:"required,service"`
+}
+
+// ListLicensesRequest represents a request to get all licenses of an organization
+type ListLicensesRequest struct {
+	Requestor           string `validate:"required"`
+	RequestorIsOrgAdmin bool
+	OrgID               string `validate:"required,identifier"`
+}
+
+// GetUserLicensesRequest represents a request to get the licenses a user holds seats in
+type GetUserLicensesRequest struct {
+	Requestor           string `validate:"required"`
+	RequestorIsOrgAdmin bool
+	OrgID               string `validate:"required,identifier"`
+	UserID              string `validate:"required,identifier"`
+}
+
+// LicenseAdminRequest represents a request to grant or revoke the management of a license to a user
+type LicenseAdminRequest struct {
+	Requestor           string `validate:"required"`
+	RequestorIsOrgAdmin bool
+	OrgID               string `validate:"required,identifier"`
+	ServiceID           string `validate:"required,service"`
+	UserID              string `validate:"required,identifier"`
+}
+
+// SeatReclamationRequest represents a request to enable or disable the automatic unassignment of seats held by disabled users for a license
+type SeatReclamationRequest struct {
+	Requestor           string `validate:"required"`
+	RequestorIsOrgAdmin bool
+	OrgID               string `validate:"required,identifier"`
+	ServiceID           string `validate:"required,service"`
+	Enabled             bool
 }
 
 // OrgEntitledEvent represents an event where an organization has been entitled with a new license
 type OrgEntitledEvent struct {
+	OrgID     string    `validate:"required,identifier"`
+	ServiceID string    `validate:"required,service"`
+	MaxSeats  int       `validate:"required,gt=0"`
+	StartDate time.Time // optional, zero means valid immediately
+	EndDate   time.Time `validate:"omitempty,gtfield=StartDate"` // optional, zero means the license does not expire
+	Requestor string    // optional, the subject who requested the entitlement, recorded in the audit log
+}
+
+// EntitlementUpdatedEvent represents an event where the number of seats of an existing license has changed
+type EntitlementUpdatedEvent struct {
+	OrgID           string `validate:"required,identifier"`
+	ServiceID       string `validate:"required,service"`
+	MaxSeats        int    `validate:"required,gt=0"`
+	DowngradePolicy string `validate:"required,in=reject+report"`
+	Requestor       string // optional, the subject who requested the update, recorded in the audit log
+}
+
+// EntitlementRevokedEvent represents an event where the license of an organization for a service has been revoked
+type EntitlementRevokedEvent struct {
 	OrgID     string `validate:"required,identifier"`
 	ServiceID string `validate:"required,service"`
-	MaxSeats  int    `validate:"required,gt=0"`
+	Requestor string // optional, the subject who requested the revocation, recorded in the audit log
+}
+
+// UpdateEntitlementResult contains the seat counts of a license after its entitlement was updated
+type UpdateEntitlementResult struct {
+	SeatsTotal     int
+	SeatsAvailable int
+	SeatsOverage   int
 }
 
 // ImportOrgEvent triggers new user import for an org
 type ImportOrgEvent struct {
-	OrgID string `validate:"required,identifier"`
+	OrgID     string `validate:"required,identifier"`
+	Requestor string // optional, the subject who requested the import, recorded in the audit log
 }
 
 // ImportUsersResult contains counters for imported and not imported users.
@@ -64,22 +143,25 @@ type ImportUsersResult struct {
 }
 
 // NewLicenseAppService ctor.
-func NewLicenseAppService(accessRepo contracts.AccessRepository, seatRepo contracts.SeatLicenseRepository, principalRepo contracts.PrincipalRepository, subjectRepo contracts.SubjectRepository, orgRepo contracts.OrganizationRepository) *LicenseAppService {
+func NewLicenseAppService(accessRepo contracts.AccessRepository, seatRepo contracts.SeatLicenseRepository, principalRepo contracts.PrincipalRepository, subjectRepo contracts.SubjectRepository, orgRepo contracts.OrganizationRepository, auditLog contracts.AuditLog) *LicenseAppService {
 	return &LicenseAppService{
 		accessRepo:    accessRepo,
 		seatRepo:      seatRepo,
 		principalRepo: principalRepo,
 		subjectRepo:   subjectRepo,
 		orgRepo:       orgRepo,
-		ctx:           context.Background(),
+		auditLog:      auditLog,
 	}
 }
 
-// GetSeatAssignmentCounts gets the seat limit and current allocation for a license
-func (s *LicenseAppService) GetSeatAssignmentCounts(req GetSeatAssignmentCountsRequest) (limit int, available int, err error) {
+// GetLicense gets the license including seat limit, current allocation and term
+func (s *LicenseAppService) GetLicense(ctx context.Context, req GetSeatAssignmentCountsRequest) (lic *domain.License, err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.GetLicense", orgAttr(req.OrgID), serviceAttr(req.ServiceID))
+	defer endSpan(span, &err)
+
 	err = ValidateStruct(req)
 	if err != nil {
-		return 0, 0, err
+		return nil, err
 	}
 
 	evt := domain.GetLicenseEvent{
@@ -88,10 +170,59 @@ func (s *LicenseAppService) GetSeatAssignmentCounts(req GetSeatAssignmentCountsR
 	}
 
 	evt.Requestor = domain.SubjectID(req.Requestor)
+	evt.RequestorIsOrgAdmin = req.RequestorIsOrgAdmin
 
 	seatsService := services.NewSeatLicenseService(s.seatRepo, s.accessRepo)
 
-	lic, err := seatsService.GetLicense(evt)
+	return seatsService.GetLicense(ctx, evt)
+}
+
+// ListLicenses gets all licenses of an organization, ordered by service ID
+func (s *LicenseAppService) ListLicenses(ctx context.Context, req ListLicensesRequest) (licenses []*domain.License, err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.ListLicenses", orgAttr(req.OrgID))
+	defer endSpan(span, &err)
+
+	err = ValidateStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	evt := domain.ListLicensesEvent{
+		Requestor:           domain.SubjectID(req.Requestor),
+		RequestorIsOrgAdmin: req.RequestorIsOrgAdmin,
+		OrgID:               req.OrgID,
+	}
+
+	seatsService := services.NewSeatLicenseService(s.seatRepo, s.accessRepo)
+
+	return seatsService.GetLicenses(ctx, evt)
+}
+
+// GetUserLicenses gets the seats a user holds in the licenses of an organization, ordered by service ID
+func (s *LicenseAppService) GetUserLicenses(ctx context.Context, req GetUserLicensesRequest) (assignments []domain.SeatAssignment, err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.GetUserLicenses", orgAttr(req.OrgID))
+	defer endSpan(span, &err)
+
+	err = ValidateStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	evt := domain.GetUserLicensesEvent{
+		Requestor:           domain.SubjectID(req.Requestor),
+		RequestorIsOrgAdmin: req.RequestorIsOrgAdmin,
+		OrgID:               req.OrgID,
+		SubjectID:           domain.SubjectID(req.UserID),
+	}
+
+	seatsService := services.NewSeatLicenseService(s.seatRepo, s.accessRepo)
+
+	return seatsService.GetSeatAssignments(ctx, evt)
+}
+
+// GetSeatAssignmentCounts gets the seat limit and current allocation for a license
+func (s *LicenseAppService) GetSeatAssignmentCounts(ctx context.Context, req GetSeatAssignmentCountsRequest) (limit int, available int, err error) {
+	lic, err := s.GetLicense(ctx, req)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -102,9 +233,23 @@ func (s *LicenseAppService) GetSeatAssignmentCounts(req GetSeatAssignmentCountsR
 	return
 }
 
-// GetSeatAssignments gets the subjects assigned to seats in a license
-func (s *LicenseAppService) GetSeatAssignments(req GetSeatAssignmentRequest) ([]domain.Principal, error) {
-	err := ValidateStruct(req)
+// GetSeatAssignments gets a page of the subjects assigned or assignable to seats in a license
+func (s *LicenseAppService) GetSeatAssignments(ctx context.Context, req GetSeatAssignmentRequest) (result *GetSeatAssignmentsResult, err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.GetSeatAssignments", orgAttr(req.OrgID), serviceAttr(req.ServiceID),
+		attribute.Bool("authz.assigned", req.Assigned), attribute.Bool("authz.include_users", req.IncludeUsers))
+	defer endSpan(span, &err)
+
+	err = ValidateStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	order := domain.PrincipalSortOrder(req.SortBy)
+	if order == "" {
+		order = domain.PrincipalSortByID
+	}
+
+	after, err := decodePageToken(req.PageToken, order)
 	if err != nil {
 		return nil, err
 	}
@@ -115,38 +260,135 @@ func (s *LicenseAppService) GetSeatAssignments(req GetSeatAssignmentRequest) ([]
 	}
 
 	evt.Requestor = domain.SubjectID(req.Requestor)
+	evt.RequestorIsOrgAdmin = req.RequestorIsOrgAdmin
 
 	seatService := services.NewSeatLicenseService(s.seatRepo, s.accessRepo)
 
 	var resultIds []domain.SubjectID
 	if req.Assigned {
-		resultIds, err = seatService.GetAssignedSeats(evt)
+		resultIds, err = seatService.GetAssignedSeats(ctx, evt)
 	} else {
-		resultIds, err = seatService.GetAssignableSeats(evt)
+		resultIds, err = seatService.GetAssignableSeats(ctx, evt)
 	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	if req.IncludeUsers {
+	// One more principal than requested tells whether there is a next page
+	query := domain.PrincipalQuery{SortBy: order, Search: req.Search, After: after}
+	if req.PageSize > 0 {
+		query.Limit = req.PageSize + 1
+	}
+
+	var principals []domain.Principal
+	if order == domain.PrincipalSortByID && req.Search == "" {
+		// The repository returns IDs in order, so only the requested page has to be looked up
+		if principals, err = s.getPrincipals(ctx, pageOfIDs(resultIds, query), req.IncludeUsers); err != nil {
+			return nil, err
+		}
+		domain.SortPrincipals(principals, order) // the principal repository does not guarantee to keep the order
+	} else {
+		// Sorting by or searching in user details is left to the principal repository
 		if len(resultIds) > 0 {
-			return s.principalRepo.GetByIDs(resultIds)
+			if principals, err = s.principalRepo.GetPageByIDs(ctx, resultIds, query); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result = &GetSeatAssignmentsResult{Principals: principals}
+	if req.PageSize > 0 && len(principals) > req.PageSize {
+		result.Principals = principals[:req.PageSize]
+		result.NextPageToken = encodePageToken(domain.CursorOf(principals[req.PageSize-1], order), order)
+	}
+	if result.Principals == nil {
+		result.Principals = []domain.Principal{}
+	}
+	if !req.IncludeUsers {
+		for i, p := range result.Principals {
+			result.Principals[i] = domain.Principal{ID: p.ID}
+		}
+	}
+
+	return result, nil
+}
+
+func (s *LicenseAppService) getPrincipals(ctx context.Context, ids []domain.SubjectID, includeUsers bool) ([]domain.Principal, error) {
+	if includeUsers {
+		if len(ids) > 0 {
+			return s.principalRepo.GetByIDs(ctx, ids)
 		}
 
 		return []domain.Principal{}, nil
 	}
 
-	principals := make([]domain.Principal, len(resultIds))
-	for i, id := range resultIds {
+	principals := make([]domain.Principal, len(ids))
+	for i, id := range ids {
 		principals[i] = domain.Principal{ID: id}
 	}
 	return principals, nil
 }
 
+// pageOfIDs returns the IDs of the page selected by a query ordered by ID without search term, given all IDs in order
+func pageOfIDs(ids []domain.SubjectID, query domain.PrincipalQuery) []domain.SubjectID {
+	start := 0
+	if query.After != nil {
+		start = sort.Search(len(ids), func(i int) bool { return ids[i] > query.After.ID })
+	}
+
+	end := len(ids)
+	if query.Limit > 0 && start+query.Limit < end {
+		end = start + query.Limit
+	}
+	return ids[start:end]
+}
+
+// pageToken is the position of the last principal of a page, together with the order it was positioned in
+type pageToken struct {
+	Order   domain.PrincipalSortOrder `json:"o"`
+	SortKey string                    `json:"k,omitempty"`
+	ID      domain.SubjectID          `json:"id"`
+}
+
+func encodePageToken(last domain.PrincipalCursor, order domain.PrincipalSortOrder) string {
+	raw, err := json.Marshal(pageToken{Order: order, SortKey: last.SortKey, ID: last.ID})
+	if err != nil {
+		panic(err) // strings always marshal
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodePageToken returns the position to continue after, nil for the first page. Tokens of pages in another order are rejected, as their positions do not apply.
+func decodePageToken(token string, order domain.PrincipalSortOrder) (*domain.PrincipalCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, domain.NewErrInvalidRequest("invalid page token")
+	}
+
+	var decoded pageToken
+	if err := json.Unmarshal(raw, &decoded); err != nil || decoded.ID == "" {
+		return nil, domain.NewErrInvalidRequest("invalid page token")
+	}
+	if decoded.Order != order {
+		return nil, domain.NewErrInvalidRequest("page token of another sort order")
+	}
+
+	return &domain.PrincipalCursor{SortKey: decoded.SortKey, ID: decoded.ID}, nil
+}
+
 // ModifySeats Assign and/or unassign a number of users for a given org and service
-func (s *LicenseAppService) ModifySeats(req ModifySeatAssignmentRequest) error {
-	err := ValidateStruct(req)
+func (s *LicenseAppService) ModifySeats(ctx context.Context, req ModifySeatAssignmentRequest) (err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.ModifySeats", orgAttr(req.OrgID), serviceAttr(req.ServiceID),
+		attribute.Int("authz.assign_count", len(req.Assign)), attribute.Int("authz.unassign_count", len(req.Unassign)))
+	defer endSpan(span, &err)
+
+	err = ValidateStruct(req)
 	if err != nil {
 		return err
 	}
@@ -157,6 +399,7 @@ func (s *LicenseAppService) ModifySeats(req ModifySeatAssignmentRequest) error {
 	}
 
 	evt.Requestor = domain.SubjectID(req.Requestor)
+	evt.RequestorIsOrgAdmin = req.RequestorIsOrgAdmin
 
 	evt.Assign = make([]domain.SubjectID, len(req.Assign))
 	for i, id := range req.Assign {
@@ -168,24 +411,130 @@ func (s *LicenseAppService) ModifySeats(req ModifySeatAssignmentRequest) error {
 		evt.UnAssign[i] = domain.SubjectID(id)
 	}
 
+	defer func() {
+		s.audit(ctx, domain.AuditRecord{
+			Requestor:  evt.Requestor,
+			Operation:  domain.AuditModifySeats,
+			OrgID:      req.OrgID,
+			ServiceID:  req.ServiceID,
+			Assigned:   evt.Assign,
+			Unassigned: evt.UnAssign,
+		}, err)
+	}()
+
 	seatService := services.NewSeatLicenseService(s.seatRepo, s.accessRepo)
 
-	return seatService.ModifySeats(evt)
+	return seatService.ModifySeats(ctx, evt)
+}
+
+// GrantLicenseAdmin allows a user to manage the license of an organization for a service
+func (s *LicenseAppService) GrantLicenseAdmin(ctx context.Context, req LicenseAdminRequest) (err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.GrantLicenseAdmin", orgAttr(req.OrgID), serviceAttr(req.ServiceID))
+	defer endSpan(span, &err)
+
+	evt, err := s.licenseAdminEvent(req)
+	if err != nil {
+		return err
+	}
+
+	seatService := services.NewSeatLicenseService(s.seatRepo, s.accessRepo)
+
+	return seatService.GrantLicenseAdmin(ctx, evt)
+}
+
+// RevokeLicenseAdmin withdraws the permission of a user to manage the license of an organization for a service
+func (s *LicenseAppService) RevokeLicenseAdmin(ctx context.Context, req LicenseAdminRequest) (err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.RevokeLicenseAdmin", orgAttr(req.OrgID), serviceAttr(req.ServiceID))
+	defer endSpan(span, &err)
+
+	evt, err := s.licenseAdminEvent(req)
+	if err != nil {
+		return err
+	}
+
+	seatService := services.NewSeatLicenseService(s.seatRepo, s.accessRepo)
+
+	return seatService.RevokeLicenseAdmin(ctx, evt)
+}
+
+func (s *LicenseAppService) licenseAdminEvent(req LicenseAdminRequest) (domain.LicenseAdminEvent, error) {
+	if err := ValidateStruct(req); err != nil {
+		return domain.LicenseAdminEvent{}, err
+	}
+
+	evt := domain.LicenseAdminEvent{
+		Org:       domain.Organization{ID: req.OrgID},
+		Service:   domain.Service{ID: req.ServiceID},
+		SubjectID: domain.SubjectID(req.UserID),
+	}
+
+	evt.Requestor = domain.SubjectID(req.Requestor)
+	evt.RequestorIsOrgAdmin = req.RequestorIsOrgAdmin
+
+	return evt, nil
+}
+
+// SetSeatReclamation enables or disables the automatic unassignment of seats held by disabled users for the license of an organization for a service
+func (s *LicenseAppService) SetSeatReclamation(ctx context.Context, req SeatReclamationRequest) (err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.SetSeatReclamation", orgAttr(req.OrgID), serviceAttr(req.ServiceID))
+	defer endSpan(span, &err)
+
+	if err = ValidateStruct(req); err != nil {
+		return err
+	}
+
+	evt := domain.SeatReclamationEvent{
+		Org:     domain.Organization{ID: req.OrgID},
+		Service: domain.Service{ID: req.ServiceID},
+		Enabled: req.Enabled,
+	}
+
+	evt.Requestor = domain.SubjectID(req.Requestor)
+	evt.RequestorIsOrgAdmin = req.RequestorIsOrgAdmin
+
+	seatService := services.NewSeatLicenseService(s.seatRepo, s.accessRepo)
+
+	return seatService.SetSeatReclamation(ctx, evt)
+}
+
+// ReclaimDisabledSeats unassigns the disabled users of all licenses that have seat reclamation enabled and returns the number of seats reclaimed. It continues after failures on single licenses.
+func (s *LicenseAppService) ReclaimDisabledSeats(ctx context.Context) (reclaimed int, err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.ReclaimDisabledSeats")
+	defer endSpan(span, &err)
+
+	reclaimed, err = services.NewSeatReclamationService(s.seatRepo).Sweep(ctx)
+	span.SetAttributes(attribute.Int("authz.reclaimed_count", reclaimed))
+	return reclaimed, err
 }
 
 // HandleOrgEntitledEvent handles the OrgEntitledEvent by storing the license and importing users
-func (s *LicenseAppService) HandleOrgEntitledEvent(evt OrgEntitledEvent) error {
-	err := ValidateStruct(evt)
+func (s *LicenseAppService) HandleOrgEntitledEvent(ctx context.Context, evt OrgEntitledEvent) (err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.HandleOrgEntitledEvent", orgAttr(evt.OrgID), serviceAttr(evt.ServiceID))
+	defer endSpan(span, &err)
+
+	err = ValidateStruct(evt)
 	if err != nil {
 		return err
 	}
 
-	err = s.seatRepo.ApplyLicense(&domain.License{
+	defer func() {
+		s.audit(ctx, domain.AuditRecord{
+			Requestor: domain.SubjectID(evt.Requestor),
+			Operation: domain.AuditEntitleOrg,
+			OrgID:     evt.OrgID,
+			ServiceID: evt.ServiceID,
+			Detail:    fmt.Sprintf("maxSeats=%d", evt.MaxSeats),
+		}, err)
+	}()
+
+	err = s.seatRepo.ApplyLicense(ctx, &domain.License{
 		OrgID:     evt.OrgID,
 		ServiceID: evt.ServiceID,
 		MaxSeats:  evt.MaxSeats,
 		Version:   "l",
 		InUse:     0,
+		StartDate: evt.StartDate,
+		EndDate:   evt.EndDate,
 	})
 
 	if err != nil {
@@ -193,21 +542,109 @@ func (s *LicenseAppService) HandleOrgEntitledEvent(evt OrgEntitledEvent) error {
 	}
 
 	// always run import.
-	_, e := s.importUsers(evt.OrgID)
+	_, e := s.importUsers(ctx, evt.OrgID)
 	if e != nil {
 		return err
 	}
 	return nil
 }
 
-// HandleSubjectAddOrUpdateEvent handles the SubjectAddOrUpdateEvent by adding the user updates to the spicedb schema
-func (s *LicenseAppService) HandleSubjectAddOrUpdateEvent(evt contracts.SubjectAddOrUpdateEvent) error {
-	err := ValidateStruct(evt)
+// HandleEntitlementUpdatedEvent handles the EntitlementUpdatedEvent by replacing the seat limit of the existing license
+func (s *LicenseAppService) HandleEntitlementUpdatedEvent(ctx context.Context, evt EntitlementUpdatedEvent) (result *UpdateEntitlementResult, err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.HandleEntitlementUpdatedEvent", orgAttr(evt.OrgID), serviceAttr(evt.ServiceID))
+	defer endSpan(span, &err)
+
+	err = ValidateStruct(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	var lic *domain.License
+	defer func() {
+		detail := fmt.Sprintf("maxSeats=%d downgradePolicy=%s", evt.MaxSeats, evt.DowngradePolicy)
+		if lic != nil {
+			detail += fmt.Sprintf(" overage=%d", lic.GetOverage())
+		}
+		s.audit(ctx, domain.AuditRecord{
+			Requestor: domain.SubjectID(evt.Requestor),
+			Operation: domain.AuditUpdateEntitlement,
+			OrgID:     evt.OrgID,
+			ServiceID: evt.ServiceID,
+			Detail:    detail,
+		}, err)
+	}()
+
+	seatService := services.NewSeatLicenseService(s.seatRepo, s.accessRepo)
+
+	lic, err = seatService.UpdateLicense(ctx, domain.UpdateLicenseEvent{
+		OrgID:           evt.OrgID,
+		ServiceID:       evt.ServiceID,
+		MaxSeats:        evt.MaxSeats,
+		DowngradePolicy: domain.DowngradePolicy(evt.DowngradePolicy),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if lic.GetOverage() > 0 {
+		glog.Warningf("License %s for org %s downgraded to %d seats with %d seats in use. Overage: %d", evt.ServiceID, evt.OrgID, lic.MaxSeats, lic.InUse, lic.GetOverage())
+	}
+
+	return &UpdateEntitlementResult{
+		SeatsTotal:     lic.MaxSeats,
+		SeatsAvailable: lic.GetAvailableSeats(),
+		SeatsOverage:   lic.GetOverage(),
+	}, nil
+}
+
+// HandleEntitlementRevokedEvent handles the EntitlementRevokedEvent by removing the license and all its seat assignments
+func (s *LicenseAppService) HandleEntitlementRevokedEvent(ctx context.Context, evt EntitlementRevokedEvent) (err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.HandleEntitlementRevokedEvent", orgAttr(evt.OrgID), serviceAttr(evt.ServiceID))
+	defer endSpan(span, &err)
+
+	err = ValidateStruct(evt)
 	if err != nil {
 		return err
 	}
 
-	isOrgLicensed, err := s.seatRepo.HasAnyLicense(evt.OrgID)
+	defer func() {
+		s.audit(ctx, domain.AuditRecord{
+			Requestor: domain.SubjectID(evt.Requestor),
+			Operation: domain.AuditRevokeEntitlement,
+			OrgID:     evt.OrgID,
+			ServiceID: evt.ServiceID,
+		}, err)
+	}()
+
+	return s.seatRepo.RevokeLicense(ctx, evt.OrgID, evt.ServiceID)
+}
+
+// HandleSubjectAddOrUpdateEvent handles the SubjectAddOrUpdateEvent by adding the user updates to the spicedb schema. Deleted subjects, and subjects that moved to another org, are removed from their previous orgs and their seats there are released. Disabled subjects lose their seats in licenses with seat reclamation enabled.
+func (s *LicenseAppService) HandleSubjectAddOrUpdateEvent(ctx context.Context, evt contracts.SubjectAddOrUpdateEvent) (err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.HandleSubjectAddOrUpdateEvent", orgAttr(evt.OrgID),
+		attribute.Bool("authz.active", evt.Active), attribute.Bool("authz.deleted", evt.Deleted))
+	defer endSpan(span, &err)
+
+	err = ValidateStruct(evt)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		s.audit(ctx, domain.AuditRecord{
+			Operation: domain.AuditSubjectEvent,
+			OrgID:     evt.OrgID,
+			SubjectID: domain.SubjectID(evt.SubjectID),
+			Detail:    fmt.Sprintf("active=%t deleted=%t", evt.Active, evt.Deleted),
+		}, err)
+	}()
+
+	err = s.removeSubjectFromPreviousOrgs(ctx, evt)
+	if err != nil || evt.Deleted {
+		return err
+	}
+
+	isOrgLicensed, err := s.seatRepo.HasAnyLicense(ctx, evt.OrgID)
 	if err != nil {
 		return err
 	}
@@ -217,23 +654,70 @@ func (s *LicenseAppService) HandleSubjectAddOrUpdateEvent(evt contracts.SubjectA
 		return nil
 	}
 
-	err = s.orgRepo.UpsertSubject(evt.OrgID, domain.Subject{
+	err = s.orgRepo.UpsertSubject(ctx, evt.OrgID, domain.Subject{
 		SubjectID: domain.SubjectID(evt.SubjectID),
 		Enabled:   evt.Active,
 	})
+	if err != nil || evt.Active {
+		return err
+	}
+
+	return services.NewSeatReclamationService(s.seatRepo).ReclaimSeatsOfSubject(ctx, evt.OrgID, domain.SubjectID(evt.SubjectID))
+}
+
+func (s *LicenseAppService) removeSubjectFromPreviousOrgs(ctx context.Context, evt contracts.SubjectAddOrUpdateEvent) error {
+	subjectID := domain.SubjectID(evt.SubjectID)
+	orgIDs, err := s.orgRepo.GetSubjectOrgs(ctx, subjectID)
+	if err != nil {
+		return err
+	}
+
+	// The membership of a deleted subject may already be gone if an earlier attempt was interrupted, while seats remain
+	if evt.Deleted && evt.OrgID != "" {
+		orgIDs = append(orgIDs, evt.OrgID)
+	}
 
-	return err
+	membershipService := services.NewMembershipService(s.seatRepo, s.orgRepo)
+	removed := make(map[string]bool, len(orgIDs))
+	for _, orgID := range orgIDs {
+		if removed[orgID] || (!evt.Deleted && orgID == evt.OrgID) {
+			continue
+		}
+		removed[orgID] = true
+
+		glog.Infof("Removing subject %s from org %s and releasing its seats.", subjectID, orgID)
+		if err := membershipService.RemoveSubject(ctx, orgID, subjectID); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // ImportUsersForOrg imports users for a given orgID and returns a result containing a count of imported and not imported users
-func (s *LicenseAppService) ImportUsersForOrg(evt ImportOrgEvent) (*ImportUsersResult, error) {
-	err := ValidateStruct(evt)
+func (s *LicenseAppService) ImportUsersForOrg(ctx context.Context, evt ImportOrgEvent) (result *ImportUsersResult, err error) {
+	ctx, span := startSpan(ctx, "LicenseAppService.ImportUsersForOrg", orgAttr(evt.OrgID))
+	defer endSpan(span, &err)
+
+	err = ValidateStruct(evt)
 	if err != nil {
 		return nil, err
 	}
 
+	defer func() {
+		record := domain.AuditRecord{
+			Requestor: domain.SubjectID(evt.Requestor),
+			Operation: domain.AuditImportOrg,
+			OrgID:     evt.OrgID,
+		}
+		if result != nil {
+			record.Detail = fmt.Sprintf("imported=%d notImported=%d", result.ImportedUsersCount, result.NotImportedUsersCount)
+		}
+		s.audit(ctx, record, err)
+	}()
+
 	// always run import.
-	result, err := s.importUsers(evt.OrgID)
+	result, err = s.importUsers(ctx, evt.OrgID)
 	if err != nil {
 		return nil, err
 	}
@@ -241,9 +725,9 @@ func (s *LicenseAppService) ImportUsersForOrg(evt ImportOrgEvent) (*ImportUsersR
 	return result, nil
 }
 
-func (s *LicenseAppService) importUsers(orgID string) (*ImportUsersResult, error) {
+func (s *LicenseAppService) importUsers(ctx context.Context, orgID string) (*ImportUsersResult, error) {
 	// always run import.
-	subjects, errors := s.subjectRepo.GetByOrgID(orgID)
+	subjects, errors := s.subjectRepo.GetByOrgID(ctx, orgID)
 
 	var importedUsersCount uint64
 	var failedUserImportCount uint64
@@ -253,7 +737,7 @@ loop:
 		select {
 		case subject, ok := <-subjects:
 			if ok {
-				err := s.orgRepo.AddSubject(orgID, subject)
+				err := s.orgRepo.AddSubject(ctx, orgID, subject)
 				if err != nil {
 					atomic.AddUint64(&failedUserImportCount, 1)
 					glog.Errorf("Failed to import user %s to org %s", subject.SubjectID, orgID)
@@ -289,6 +773,22 @@ loop:
 	}, nil
 }
 
+// audit records the outcome of a license mutation. Failing to record it is logged, but does not fail the mutation, which already took place.
+func (s *LicenseAppService) audit(ctx context.Context, record domain.AuditRecord, err error) {
+	if s.auditLog == nil {
+		return
+	}
+
+	record.Time = time.Now().UTC()
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	if err := s.auditLog.AddRecord(ctx, record); err != nil {
+		glog.Errorf("Error adding audit record %+v: %v", record, err)
+	}
+}
+
 func errorShouldBeRetried(err error) bool {
 	return err != domain.ErrSubjectAlreadyExists
 }
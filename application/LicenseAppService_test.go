@@ -4,6 +4,7 @@ import (
 	"authz/domain"
 	"authz/domain/contracts"
 	spicedb "authz/infrastructure/repository/authzed"
+	"authz/infrastructure/repository/memory"
 	"authz/infrastructure/repository/mock"
 	"context"
 	"testing"
@@ -24,14 +25,14 @@ func TestOrgEnablement(t *testing.T) {
 	}
 
 	//When
-	err := service.HandleOrgEntitledEvent(evt)
+	err := service.HandleOrgEntitledEvent(context.Background(), evt)
 
 	//Then
 	assert.NoError(t, err)
 
 	spicedbContainer.WaitForQuantizationInterval()
 
-	limit, available, err := service.GetSeatAssignmentCounts(GetSeatAssignmentCountsRequest{
+	limit, available, err := service.GetSeatAssignmentCounts(context.Background(), GetSeatAssignmentCountsRequest{
 		Requestor: "system",
 		OrgID:     evt.OrgID,
 		ServiceID: evt.ServiceID,
@@ -40,7 +41,7 @@ func TestOrgEnablement(t *testing.T) {
 	assert.Equal(t, evt.MaxSeats, limit)
 	assert.Equal(t, evt.MaxSeats, available) //None in use
 
-	assignable, err := service.GetSeatAssignments(GetSeatAssignmentRequest{
+	assignable, err := service.GetSeatAssignments(context.Background(), GetSeatAssignmentRequest{
 		Requestor:    "system",
 		OrgID:        evt.OrgID,
 		ServiceID:    evt.ServiceID,
@@ -48,7 +49,7 @@ func TestOrgEnablement(t *testing.T) {
 		Assigned:     false,
 	})
 	assert.NoError(t, err)
-	assert.Equal(t, 20, len(assignable))
+	assert.Equal(t, 20, len(assignable.Principals))
 }
 
 func TestModifySeatsRequestElementsAreValidated(t *testing.T) {
@@ -94,7 +95,7 @@ func TestOrgEnablementWithNegativeSeats(t *testing.T) {
 		MaxSeats:  -1,
 	}
 
-	err := service.HandleOrgEntitledEvent(evt)
+	err := service.HandleOrgEntitledEvent(context.Background(), evt)
 
 	var validationErr domain.ErrInvalidRequest
 
@@ -107,7 +108,7 @@ func TestOrgImportWithImproperOrgID(t *testing.T) {
 		OrgID: "!!!",
 	}
 
-	_, err := service.ImportUsersForOrg(evt)
+	_, err := service.ImportUsersForOrg(context.Background(), evt)
 	var validationErr domain.ErrInvalidRequest
 	assert.ErrorAs(t, err, &validationErr)
 
@@ -129,14 +130,14 @@ func TestSameOrgAndServiceAddedTwiceNotPossible(t *testing.T) {
 	}
 
 	//When
-	err := service.HandleOrgEntitledEvent(evt)
+	err := service.HandleOrgEntitledEvent(context.Background(), evt)
 	assert.NoError(t, err)
-	err = service.HandleOrgEntitledEvent(evt2)
+	err = service.HandleOrgEntitledEvent(context.Background(), evt2)
 	assert.Error(t, err)
 
 	spicedbContainer.WaitForQuantizationInterval()
 
-	limit, available, err := service.GetSeatAssignmentCounts(GetSeatAssignmentCountsRequest{
+	limit, available, err := service.GetSeatAssignmentCounts(context.Background(), GetSeatAssignmentCountsRequest{
 		Requestor: "system",
 		OrgID:     evt.OrgID,
 		ServiceID: evt.ServiceID,
@@ -145,7 +146,7 @@ func TestSameOrgAndServiceAddedTwiceNotPossible(t *testing.T) {
 	assert.Equal(t, evt.MaxSeats, limit)
 	assert.Equal(t, evt.MaxSeats, available) //None in use
 
-	assignable, err := service.GetSeatAssignments(GetSeatAssignmentRequest{
+	assignable, err := service.GetSeatAssignments(context.Background(), GetSeatAssignmentRequest{
 		Requestor:    "system",
 		OrgID:        evt.OrgID,
 		ServiceID:    evt.ServiceID,
@@ -153,7 +154,249 @@ func TestSameOrgAndServiceAddedTwiceNotPossible(t *testing.T) {
 		Assigned:     false,
 	})
 	assert.NoError(t, err)
-	assert.Equal(t, 20, len(assignable))
+	assert.Equal(t, 20, len(assignable.Principals))
+}
+
+func TestEntitlementUpdateForEntitledOrg(t *testing.T) {
+	//Given
+	service, _ := createService(nil, nil)
+	err := service.HandleOrgEntitledEvent(context.Background(), OrgEntitledEvent{
+		OrgID:     "o2",
+		ServiceID: "smarts",
+		MaxSeats:  2,
+	})
+	assert.NoError(t, err)
+
+	//When
+	result, err := service.HandleEntitlementUpdatedEvent(context.Background(), EntitlementUpdatedEvent{
+		OrgID:           "o2",
+		ServiceID:       "smarts",
+		MaxSeats:        5,
+		DowngradePolicy: "reject",
+	})
+
+	//Then
+	assert.NoError(t, err)
+	assert.Equal(t, 5, result.SeatsTotal)
+	assert.Equal(t, 5, result.SeatsAvailable)
+	assert.Equal(t, 0, result.SeatsOverage)
+
+	spicedbContainer.WaitForQuantizationInterval()
+
+	limit, available, err := service.GetSeatAssignmentCounts(context.Background(), GetSeatAssignmentCountsRequest{
+		Requestor: "system",
+		OrgID:     "o2",
+		ServiceID: "smarts",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, limit)
+	assert.Equal(t, 5, available)
+}
+
+func TestEntitlementDowngradeBelowSeatsInUse(t *testing.T) {
+	//Given (see schema/spicedb_bootstrap_relations.yaml: o1 has 2 seats in use)
+	service, _ := createService(nil, nil)
+
+	//When
+	_, err := service.HandleEntitlementUpdatedEvent(context.Background(), EntitlementUpdatedEvent{
+		OrgID:           "o1",
+		ServiceID:       "smarts",
+		MaxSeats:        1,
+		DowngradePolicy: "reject",
+	})
+
+	//Then
+	assert.ErrorIs(t, err, domain.ErrLicenseLimitExceeded)
+
+	//When
+	result, err := service.HandleEntitlementUpdatedEvent(context.Background(), EntitlementUpdatedEvent{
+		OrgID:           "o1",
+		ServiceID:       "smarts",
+		MaxSeats:        1,
+		DowngradePolicy: "report",
+	})
+
+	//Then
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.SeatsTotal)
+	assert.Equal(t, 0, result.SeatsAvailable)
+	assert.Equal(t, 1, result.SeatsOverage)
+}
+
+func TestEntitlementUpdateForUnknownLicense(t *testing.T) {
+	service, _ := createService(nil, nil)
+
+	_, err := service.HandleEntitlementUpdatedEvent(context.Background(), EntitlementUpdatedEvent{
+		OrgID:           "unknown",
+		ServiceID:       "smarts",
+		MaxSeats:        5,
+		DowngradePolicy: "reject",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrLicenseNotFound)
+}
+
+func TestEntitlementUpdateRequiresKnownDowngradePolicy(t *testing.T) {
+	err := ValidateStruct(EntitlementUpdatedEvent{
+		OrgID:           "o1",
+		ServiceID:       "smarts",
+		MaxSeats:        5,
+		DowngradePolicy: "ignore",
+	})
+
+	var validationErr domain.ErrInvalidRequest
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestEntitlementRevokedForEntitledOrg(t *testing.T) {
+	//Given (see schema/spicedb_bootstrap_relations.yaml: o1 has u1 and u3 assigned)
+	service, _ := createService(nil, nil)
+
+	//When
+	err := service.HandleEntitlementRevokedEvent(context.Background(), EntitlementRevokedEvent{
+		OrgID:     "o1",
+		ServiceID: "smarts",
+	})
+
+	//Then
+	assert.NoError(t, err)
+
+	spicedbContainer.WaitForQuantizationInterval()
+
+	assigned, err := service.GetSeatAssignments(context.Background(), GetSeatAssignmentRequest{
+		Requestor:    "system",
+		OrgID:        "o1",
+		ServiceID:    "smarts",
+		IncludeUsers: false,
+		Assigned:     true,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, assigned.Principals)
+
+	err = service.HandleEntitlementRevokedEvent(context.Background(), EntitlementRevokedEvent{
+		OrgID:     "o1",
+		ServiceID: "smarts",
+	})
+	assert.ErrorIs(t, err, domain.ErrLicenseNotFound)
+}
+
+func TestGetSeatAssignmentsInPages(t *testing.T) {
+	//Given
+	service, _ := createService(nil, nil)
+	req := GetSeatAssignmentRequest{
+		Requestor:    "system",
+		OrgID:        "o1",
+		ServiceID:    "smarts",
+		IncludeUsers: true,
+		Assigned:     false,
+		PageSize:     5,
+	}
+
+	//When
+	var ids []domain.SubjectID
+	pages := 0
+	for {
+		page, err := service.GetSeatAssignments(context.Background(), req)
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, len(page.Principals), req.PageSize)
+
+		for _, p := range page.Principals {
+			ids = append(ids, p.ID)
+		}
+		pages++
+
+		if page.NextPageToken == "" {
+			break
+		}
+		req.PageToken = page.NextPageToken
+	}
+
+	//Then
+	assert.Equal(t, 4, pages)
+	assert.Len(t, ids, 17) //u1 and u3 are assigned, u3 and u4 are disabled
+	assert.Equal(t, []domain.SubjectID{"u10", "u11", "u12", "u13", "u14"}, ids[:5])
+}
+
+func TestGetSeatAssignmentsWithSearchAndSort(t *testing.T) {
+	//Given
+	service, _ := createService(nil, nil)
+
+	//When
+	page, err := service.GetSeatAssignments(context.Background(), GetSeatAssignmentRequest{
+		Requestor:    "system",
+		OrgID:        "o1",
+		ServiceID:    "smarts",
+		IncludeUsers: false,
+		Assigned:     false,
+		SortBy:       "username",
+		Search:       "USER_1",
+		PageSize:     3,
+	})
+
+	//Then
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Principal{{ID: "u10"}, {ID: "u11"}, {ID: "u12"}}, page.Principals)
+	assert.NotEmpty(t, page.NextPageToken)
+}
+
+func TestGetSeatAssignmentsRejectsInvalidPaging(t *testing.T) {
+	service, _ := createService(nil, nil)
+	req := GetSeatAssignmentRequest{
+		Requestor: "system",
+		OrgID:     "o1",
+		ServiceID: "smarts",
+		Assigned:  true,
+	}
+
+	var validationErr domain.ErrInvalidRequest
+
+	invalidToken := req
+	invalidToken.PageToken = "not a token"
+	_, err := service.GetSeatAssignments(context.Background(), invalidToken)
+	assert.ErrorAs(t, err, &validationErr)
+
+	invalidSort := req
+	invalidSort.SortBy = "email"
+	_, err = service.GetSeatAssignments(context.Background(), invalidSort)
+	assert.ErrorAs(t, err, &validationErr)
+
+	invalidPageSize := req
+	invalidPageSize.PageSize = -1
+	_, err = service.GetSeatAssignments(context.Background(), invalidPageSize)
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+func TestGetSeatAssignmentsInPagesContinueAfterTheLastPrincipal(t *testing.T) {
+	repo, err := memory.NewSeededInMemoryAccessRepository()
+	assert.NoError(t, err)
+	principals := &mock.StubPrincipalRepository{Principals: mock.GetMockPrincipalData(), DefaultOrg: "o1"}
+	service := NewLicenseAppService(repo, repo, principals, principals, repo, nil)
+	req := GetSeatAssignmentRequest{Requestor: "system", OrgID: "o1", ServiceID: "smarts", SortBy: "displayName", Search: "user 1", PageSize: 4}
+
+	first, err := service.GetSeatAssignments(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Principal{{ID: "u10"}, {ID: "u11"}, {ID: "u12"}, {ID: "u13"}}, first.Principals)
+
+	// Seats assigned in the meantime do not shift the following pages
+	assert.NoError(t, service.ModifySeats(context.Background(), ModifySeatAssignmentRequest{Requestor: "system", OrgID: "o1", ServiceID: "smarts", Assign: []string{"u10"}}))
+
+	req.PageToken = first.NextPageToken
+	second, err := service.GetSeatAssignments(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Principal{{ID: "u14"}, {ID: "u15"}, {ID: "u16"}, {ID: "u17"}}, second.Principals)
+
+	req.PageToken = second.NextPageToken
+	last, err := service.GetSeatAssignments(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Principal{{ID: "u18"}, {ID: "u19"}}, last.Principals)
+	assert.Empty(t, last.NextPageToken)
+
+	otherOrder := req
+	otherOrder.PageToken = first.NextPageToken
+	otherOrder.SortBy = "username"
+	_, err = service.GetSeatAssignments(context.Background(), otherOrder)
+	var validationErr domain.ErrInvalidRequest
+	assert.ErrorAs(t, err, &validationErr)
 }
 
 func TestSubjectChangeEventForLicensedOrg(t *testing.T) {
@@ -161,7 +404,7 @@ func TestSubjectChangeEventForLicensedOrg(t *testing.T) {
 
 	subjectID := domain.SubjectID("new-subject")
 
-	err := service.HandleSubjectAddOrUpdateEvent(contracts.SubjectAddOrUpdateEvent{
+	err := service.HandleSubjectAddOrUpdateEvent(context.Background(), contracts.SubjectAddOrUpdateEvent{
 		SubjectID: string(subjectID),
 		OrgID:     "o1",
 		Active:    true,
@@ -176,7 +419,7 @@ func TestSubjectChangeEventForUnlicensedOrg(t *testing.T) {
 
 	subjectID := domain.SubjectID("new-subject")
 
-	err := service.HandleSubjectAddOrUpdateEvent(contracts.SubjectAddOrUpdateEvent{
+	err := service.HandleSubjectAddOrUpdateEvent(context.Background(), contracts.SubjectAddOrUpdateEvent{
 		SubjectID: string(subjectID),
 		OrgID:     "new-org",
 		Active:    true,
@@ -186,6 +429,110 @@ func TestSubjectChangeEventForUnlicensedOrg(t *testing.T) {
 	assert.False(t, spicedb.CheckForSubjectRelationship(client, subjectID, "member", "org", "new-org"))
 }
 
+func TestSubjectDeletionEventReleasesSeatsAndMembership(t *testing.T) {
+	service, repo := createInMemoryService(t)
+
+	err := service.HandleSubjectAddOrUpdateEvent(context.Background(), contracts.SubjectAddOrUpdateEvent{SubjectID: "u1", OrgID: "o1", Deleted: true})
+	assert.NoError(t, err)
+
+	assigned, err := repo.GetAssigned(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SubjectID{"u3"}, assigned)
+	license, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, license.InUse)
+
+	orgs, err := repo.GetSubjectOrgs(context.Background(), "u1")
+	assert.NoError(t, err)
+	assert.Empty(t, orgs)
+
+	// Redelivery of the event is harmless
+	err = service.HandleSubjectAddOrUpdateEvent(context.Background(), contracts.SubjectAddOrUpdateEvent{SubjectID: "u1", OrgID: "o1", Deleted: true})
+	assert.NoError(t, err)
+}
+
+func TestSubjectMoveEventRemovesMembershipInPreviousOrg(t *testing.T) {
+	service, repo := createInMemoryService(t)
+	assert.NoError(t, repo.ApplyLicense(context.Background(), &domain.License{OrgID: "o2", ServiceID: "smarts", MaxSeats: 5, Version: "v"}))
+
+	err := service.HandleSubjectAddOrUpdateEvent(context.Background(), contracts.SubjectAddOrUpdateEvent{SubjectID: "u1", OrgID: "o2", Active: true})
+	assert.NoError(t, err)
+
+	orgs, err := repo.GetSubjectOrgs(context.Background(), "u1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"o2"}, orgs)
+
+	seats, err := repo.GetSeatAssignments(context.Background(), "o1", "u1")
+	assert.NoError(t, err)
+	assert.Empty(t, seats)
+	license, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, license.InUse)
+}
+
+func TestSubjectUpdateEventKeepsMembershipAndSeats(t *testing.T) {
+	service, repo := createInMemoryService(t)
+
+	err := service.HandleSubjectAddOrUpdateEvent(context.Background(), contracts.SubjectAddOrUpdateEvent{SubjectID: "u1", OrgID: "o1", Active: true})
+	assert.NoError(t, err)
+
+	seats, err := repo.GetSeatAssignments(context.Background(), "o1", "u1")
+	assert.NoError(t, err)
+	assert.Len(t, seats, 1)
+}
+
+func TestSubjectDisabledEventReclaimsSeatsIfEnabled(t *testing.T) {
+	service, repo := createInMemoryService(t)
+	assert.NoError(t, service.SetSeatReclamation(context.Background(), SeatReclamationRequest{Requestor: "okay", OrgID: "o1", ServiceID: "smarts", Enabled: true}))
+
+	err := service.HandleSubjectAddOrUpdateEvent(context.Background(), contracts.SubjectAddOrUpdateEvent{SubjectID: "u1", OrgID: "o1", Active: false})
+	assert.NoError(t, err)
+
+	// u3 was disabled before and loses its seat as well
+	assigned, err := repo.GetAssigned(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Empty(t, assigned)
+	license, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, license.InUse)
+}
+
+func TestSubjectDisabledEventKeepsSeatsByDefault(t *testing.T) {
+	service, repo := createInMemoryService(t)
+
+	err := service.HandleSubjectAddOrUpdateEvent(context.Background(), contracts.SubjectAddOrUpdateEvent{SubjectID: "u1", OrgID: "o1", Active: false})
+	assert.NoError(t, err)
+
+	assigned, err := repo.GetAssigned(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SubjectID{"u1", "u3"}, assigned)
+}
+
+func TestSeatReclamationRequiresLicenseManagement(t *testing.T) {
+	service, repo := createInMemoryService(t)
+
+	err := service.SetSeatReclamation(context.Background(), SeatReclamationRequest{Requestor: "u2", OrgID: "o1", ServiceID: "smarts", Enabled: true})
+	assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+	err = service.SetSeatReclamation(context.Background(), SeatReclamationRequest{Requestor: "u2", RequestorIsOrgAdmin: true, OrgID: "o1", ServiceID: "smarts", Enabled: true})
+	assert.NoError(t, err)
+	license, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.True(t, license.ReclaimDisabledSeats)
+
+	reclaimed, err := service.ReclaimDisabledSeats(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reclaimed)
+}
+
+func createInMemoryService(t *testing.T) (*LicenseAppService, *memory.InMemoryAccessRepository) {
+	repo, err := memory.NewSeededInMemoryAccessRepository()
+	assert.NoError(t, err)
+	principals := &mock.StubPrincipalRepository{}
+
+	return NewLicenseAppService(repo, repo, principals, principals, repo, nil), repo
+}
+
 func createService(subjectRepositoryOverride contracts.SubjectRepository, orgRepositoryOverride contracts.OrganizationRepository) (*LicenseAppService, *authzed.Client) {
 	spiceDbRepo, authzedClient, err := spicedbContainer.CreateClient()
 	if err != nil {
@@ -211,7 +558,7 @@ func createService(subjectRepositoryOverride contracts.SubjectRepository, orgRep
 		orgRepo = orgRepositoryOverride
 	}
 
-	return NewLicenseAppService(spiceDbRepo, spiceDbRepo, principalRepo, subjectRepo, orgRepo), authzedClient
+	return NewLicenseAppService(spiceDbRepo, spiceDbRepo, principalRepo, subjectRepo, orgRepo, nil), authzedClient
 }
 
 func TestBatchImportedDisabledUserDoesNotOverwriteEnabledUser(t *testing.T) {
@@ -227,7 +574,7 @@ func TestBatchImportedDisabledUserDoesNotOverwriteEnabledUser(t *testing.T) {
 	//When
 	doneSignal := make(chan interface{})
 	go func() {
-		err := licenseAppService.HandleOrgEntitledEvent(OrgEntitledEvent{
+		err := licenseAppService.HandleOrgEntitledEvent(context.Background(), OrgEntitledEvent{
 			OrgID:     "myorg",
 			ServiceID: "myservice",
 			MaxSeats:  5,
@@ -300,7 +647,7 @@ type InterruptableSubjectRepository struct {
 	PostInterruptSubjects []domain.Subject
 }
 
-func (r *InterruptableSubjectRepository) GetByOrgID(_ string) (chan domain.Subject, chan error) {
+func (r *InterruptableSubjectRepository) GetByOrgID(_ context.Context, _ string) (chan domain.Subject, chan error) {
 	subjects := make(chan domain.Subject)
 	errors := make(chan error)
 
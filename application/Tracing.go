@@ -0,0 +1,34 @@
+package application
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("authz/application")
+
+// startSpan starts the span of an application service operation as child of the span in ctx, if any
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// endSpan ends the span of an operation and records its error, meant to be deferred with the address of the named error result
+func endSpan(span trace.Span, err *error) {
+	if *err != nil {
+		span.RecordError(*err)
+		span.SetStatus(codes.Error, (*err).Error())
+	}
+	span.End()
+}
+
+func orgAttr(orgID string) attribute.KeyValue {
+	return attribute.String("authz.org_id", orgID)
+}
+
+func serviceAttr(serviceID string) attribute.KeyValue {
+	return attribute.String("authz.service_id", serviceID)
+}
@@ -46,3 +46,18 @@ func TestValidateValue(t *testing.T) {
 		}
 	}
 }
+
+func TestCreateWebhookRequestRequiresHTTPSURL(t *testing.T) {
+	valid := CreateWebhookRequest{Requestor: "u1", OrgID: "o1", URL: "https://example.com/hook", Secret: "0123456789abcdef"}
+	assert.NoError(t, ValidateStruct(valid))
+
+	for _, url := range []string{"http://example.com/hook", "example.com/hook", "https://", ""} {
+		req := valid
+		req.URL = url
+		assert.Error(t, ValidateStruct(req), "URL %q should be rejected", url)
+	}
+
+	req := valid
+	req.Secret = "short"
+	assert.Error(t, ValidateStruct(req))
+}
@@ -0,0 +1,146 @@
+package application
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"authz/domain/services"
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+)
+
+// WebhookAppService the handler for webhook related endpoints.
+type WebhookAppService struct {
+	accessRepo  contracts.AccessRepository
+	webhookRepo contracts.WebhookRepository
+	deliveryLog contracts.WebhookDeliveryLog
+	lookupIP    func(ctx context.Context, host string) ([]net.IP, error)
+}
+
+// CreateWebhookRequest represents a request to register a webhook for the license events of an organization
+type CreateWebhookRequest struct {
+	Requestor           string `validate:"required"`
+	RequestorIsOrgAdmin bool
+	OrgID               string `validate:"required,identifier"`
+	ServiceID           string `validate:"omitempty,service"` // empty to subscribe to the licenses of all services
+	URL                 string `validate:"required,url,startswith=https://,max=400"`
+	Secret              string `validate:"required,min=16,max=64"`
+}
+
+// ListWebhooksRequest represents a request to get the webhooks of an organization
+type ListWebhooksRequest struct {
+	Requestor           string `validate:"required"`
+	RequestorIsOrgAdmin bool
+	OrgID               string `validate:"required,identifier"`
+}
+
+// WebhookRequest represents a request to remove or inspect a webhook of an organization
+type WebhookRequest struct {
+	Requestor           string `validate:"required"`
+	RequestorIsOrgAdmin bool
+	OrgID               string `validate:"required,identifier"`
+	WebhookID           string `validate:"required,identifier"`
+}
+
+// NewWebhookAppService creates a new WebhookAppService
+func NewWebhookAppService(accessRepo contracts.AccessRepository, webhookRepo contracts.WebhookRepository, deliveryLog contracts.WebhookDeliveryLog) *WebhookAppService {
+	return &WebhookAppService{
+		accessRepo:  accessRepo,
+		webhookRepo: webhookRepo,
+		deliveryLog: deliveryLog,
+		lookupIP: func(ctx context.Context, host string) ([]net.IP, error) {
+			return net.DefaultResolver.LookupIP(ctx, "ip", host)
+		},
+	}
+}
+
+// CreateWebhook registers a webhook and returns it with its new ID. The host of the URL must only resolve to public addresses.
+func (s *WebhookAppService) CreateWebhook(ctx context.Context, req CreateWebhookRequest) (domain.Webhook, error) {
+	if err := ValidateStruct(req); err != nil {
+		return domain.Webhook{}, err
+	}
+
+	if err := s.checkPublicURL(ctx, req.URL); err != nil {
+		return domain.Webhook{}, err
+	}
+
+	evt := s.webhookEvent(req.Requestor, req.RequestorIsOrgAdmin, domain.Webhook{
+		OrgID:     req.OrgID,
+		ServiceID: req.ServiceID,
+		URL:       req.URL,
+		Secret:    req.Secret,
+	})
+
+	return s.webhookService().AddWebhook(ctx, evt)
+}
+
+// ListWebhooks gets all webhooks of an organization, ordered by ID
+func (s *WebhookAppService) ListWebhooks(ctx context.Context, req ListWebhooksRequest) ([]domain.Webhook, error) {
+	if err := ValidateStruct(req); err != nil {
+		return nil, err
+	}
+
+	evt := s.webhookEvent(req.Requestor, req.RequestorIsOrgAdmin, domain.Webhook{OrgID: req.OrgID})
+
+	return s.webhookService().GetWebhooks(ctx, evt)
+}
+
+// DeleteWebhook removes a webhook of an organization
+func (s *WebhookAppService) DeleteWebhook(ctx context.Context, req WebhookRequest) error {
+	if err := ValidateStruct(req); err != nil {
+		return err
+	}
+
+	evt := s.webhookEvent(req.Requestor, req.RequestorIsOrgAdmin, domain.Webhook{OrgID: req.OrgID, ID: req.WebhookID})
+
+	return s.webhookService().RemoveWebhook(ctx, evt)
+}
+
+// ListWebhookDeliveries gets the recorded delivery attempts to a webhook of an organization, most recent first
+func (s *WebhookAppService) ListWebhookDeliveries(ctx context.Context, req WebhookRequest) ([]domain.WebhookDelivery, error) {
+	if err := ValidateStruct(req); err != nil {
+		return nil, err
+	}
+
+	evt := s.webhookEvent(req.Requestor, req.RequestorIsOrgAdmin, domain.Webhook{OrgID: req.OrgID, ID: req.WebhookID})
+
+	return s.webhookService().GetDeliveries(ctx, evt)
+}
+
+// checkPublicURL rejects webhook URLs whose host is or resolves to an address internal to the deployment. The sender checks the addresses again when connecting, as the host may resolve differently then.
+func (s *WebhookAppService) checkPublicURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return domain.NewErrInvalidRequest("invalid webhook URL")
+	}
+
+	host := u.Hostname()
+	ips := []net.IP{net.ParseIP(host)}
+	if ips[0] == nil {
+		ips, err = s.lookupIP(ctx, host)
+		if err != nil || len(ips) == 0 {
+			return domain.NewErrInvalidRequest(fmt.Sprintf("webhook host %s cannot be resolved", host))
+		}
+	}
+
+	for _, ip := range ips {
+		if !domain.IsPublicWebhookAddress(ip) {
+			return domain.NewErrInvalidRequest(fmt.Sprintf("webhook host %s is not a public address", host))
+		}
+	}
+
+	return nil
+}
+
+func (s *WebhookAppService) webhookEvent(requestor string, requestorIsOrgAdmin bool, webhook domain.Webhook) domain.WebhookEvent {
+	evt := domain.WebhookEvent{Webhook: webhook}
+	evt.Requestor = domain.SubjectID(requestor)
+	evt.RequestorIsOrgAdmin = requestorIsOrgAdmin
+
+	return evt
+}
+
+func (s *WebhookAppService) webhookService() *services.WebhookService {
+	return services.NewWebhookService(s.webhookRepo, s.deliveryLog, s.accessRepo)
+}
@@ -0,0 +1,97 @@
+package application
+
+import (
+	"authz/domain"
+	"authz/infrastructure/repository/memory"
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhooksCanBeManagedByOrgAdmin(t *testing.T) {
+	service, _ := createWebhookService(t)
+
+	created, err := service.CreateWebhook(context.Background(), CreateWebhookRequest{Requestor: "u1", RequestorIsOrgAdmin: true, OrgID: "o1", ServiceID: "smarts", URL: "https://example.com/hook", Secret: "0123456789abcdef"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+
+	webhooks, err := service.ListWebhooks(context.Background(), ListWebhooksRequest{Requestor: "u1", RequestorIsOrgAdmin: true, OrgID: "o1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Webhook{created}, webhooks)
+
+	err = service.DeleteWebhook(context.Background(), WebhookRequest{Requestor: "u1", RequestorIsOrgAdmin: true, OrgID: "o1", WebhookID: created.ID})
+	assert.NoError(t, err)
+
+	webhooks, err = service.ListWebhooks(context.Background(), ListWebhooksRequest{Requestor: "u1", RequestorIsOrgAdmin: true, OrgID: "o1"})
+	assert.NoError(t, err)
+	assert.Empty(t, webhooks)
+}
+
+func TestWebhooksCannotBeManagedByOrdinaryUser(t *testing.T) {
+	service, _ := createWebhookService(t)
+
+	_, err := service.CreateWebhook(context.Background(), CreateWebhookRequest{Requestor: "u2", OrgID: "o1", URL: "https://example.com/hook", Secret: "0123456789abcdef"})
+	assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+	_, err = service.ListWebhooks(context.Background(), ListWebhooksRequest{Requestor: "u2", OrgID: "o1"})
+	assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+}
+
+func TestWebhookDeliveriesAreOnlyVisibleToTheOrgOfTheWebhook(t *testing.T) {
+	service, log := createWebhookService(t)
+	created, err := service.CreateWebhook(context.Background(), CreateWebhookRequest{Requestor: "u1", RequestorIsOrgAdmin: true, OrgID: "o1", URL: "https://example.com/hook", Secret: "0123456789abcdef"})
+	assert.NoError(t, err)
+	assert.NoError(t, log.AddDelivery(context.Background(), domain.WebhookDelivery{WebhookID: created.ID, Attempt: 1, StatusCode: 200}))
+
+	deliveries, err := service.ListWebhookDeliveries(context.Background(), WebhookRequest{Requestor: "u1", RequestorIsOrgAdmin: true, OrgID: "o1", WebhookID: created.ID})
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 1)
+
+	_, err = service.ListWebhookDeliveries(context.Background(), WebhookRequest{Requestor: "u1", RequestorIsOrgAdmin: true, OrgID: "o2", WebhookID: created.ID})
+	assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+}
+
+func TestWebhooksCannotTargetInternalAddresses(t *testing.T) {
+	service, _ := createWebhookService(t)
+
+	for _, url := range []string{
+		"https://127.0.0.1/hook",
+		"https://[::1]:8443/hook",
+		"https://10.0.0.1/hook",
+		"https://169.254.169.254/latest/meta-data",
+		"https://internal.example.com/hook", // resolves to a private address among others
+		"https://unknown.example.com/hook",
+	} {
+		_, err := service.CreateWebhook(context.Background(), CreateWebhookRequest{Requestor: "u1", RequestorIsOrgAdmin: true, OrgID: "o1", URL: url, Secret: "0123456789abcdef"})
+		assert.ErrorAs(t, err, &domain.ErrInvalidRequest{}, url)
+	}
+
+	webhooks, err := service.ListWebhooks(context.Background(), ListWebhooksRequest{Requestor: "u1", RequestorIsOrgAdmin: true, OrgID: "o1"})
+	assert.NoError(t, err)
+	assert.Empty(t, webhooks)
+
+	_, err = service.CreateWebhook(context.Background(), CreateWebhookRequest{Requestor: "u1", RequestorIsOrgAdmin: true, OrgID: "o1", URL: "https://93.184.216.34/hook", Secret: "0123456789abcdef"})
+	assert.NoError(t, err)
+}
+
+func createWebhookService(t *testing.T) (*WebhookAppService, *memory.InMemoryWebhookDeliveryLog) {
+	repo, err := memory.NewSeededInMemoryAccessRepository()
+	assert.NoError(t, err)
+	log := memory.NewInMemoryWebhookDeliveryLog(10)
+
+	service := NewWebhookAppService(repo, memory.NewInMemoryWebhookRepository(), log)
+	service.lookupIP = func(_ context.Context, host string) ([]net.IP, error) {
+		switch host {
+		case "example.com":
+			return []net.IP{net.ParseIP("93.184.216.34")}, nil
+		case "internal.example.com":
+			return []net.IP{net.ParseIP("93.184.216.34"), net.ParseIP("10.0.0.1")}, nil
+		default:
+			return nil, errors.New("no such host")
+		}
+	}
+	return service, log
+}
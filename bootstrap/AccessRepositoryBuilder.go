@@ -4,11 +4,13 @@ import (
 	"authz/bootstrap/serviceconfig"
 	"authz/domain/contracts"
 	"authz/infrastructure/repository/authzed"
+	"authz/infrastructure/repository/memory"
 )
 
 // AccessRepositoryBuilder is the builder containing the config for building technical implementations of the server
 type AccessRepositoryBuilder struct {
-	config *serviceconfig.ServiceConfig
+	config      *serviceconfig.ServiceConfig
+	memoryStore *memory.InMemoryAccessRepository
 }
 
 // NewAccessRepositoryBuilder returns a new AccessRepositoryBuilder instance
@@ -22,12 +24,20 @@ func (e *AccessRepositoryBuilder) WithConfig(config *serviceconfig.ServiceConfig
 	return e
 }
 
+// WithMemoryStore supplies the store to use for the "memory" store kind, so that it can be shared with other repositories. Without one, a new empty store is built.
+func (e *AccessRepositoryBuilder) WithMemoryStore(store *memory.InMemoryAccessRepository) *AccessRepositoryBuilder {
+	e.memoryStore = store
+	return e
+}
+
 // Build builds an implementation based on the given param
 func (e *AccessRepositoryBuilder) Build() (contracts.AccessRepository, error) {
 	config := e.config.StoreConfig
 	switch config.Kind {
 	case "spicedb":
 		return createSpiceDbRepository(config)
+	case "memory":
+		return memoryStoreOrNew(e.memoryStore), nil
 	default:
 		return createSpiceDbRepository(config)
 	}
@@ -42,3 +52,11 @@ func createSpiceDbRepository(config serviceconfig.StoreConfig) (contracts.Access
 	err = spicedb.NewConnection(config.Endpoint, token, true, config.UseTLS)
 	return spicedb, err
 }
+
+// memoryStoreOrNew returns the given memory store, or a new empty one if none was given
+func memoryStoreOrNew(store *memory.InMemoryAccessRepository) *memory.InMemoryAccessRepository {
+	if store == nil {
+		return memory.NewInMemoryAccessRepository()
+	}
+	return store
+}
@@ -0,0 +1,36 @@
+package bootstrap
+
+import (
+	"authz/bootstrap/serviceconfig"
+	"authz/domain/contracts"
+	"authz/infrastructure/repository/authzed/migrations"
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// schemaMigrationTimeout limits how long checking and migrating the schema at startup may take
+const schemaMigrationTimeout = 30 * time.Second
+
+// initSchema checks or migrates the schema of the store as configured, failing if the service cannot work with it
+func initSchema(config serviceconfig.StoreConfig, ar contracts.AccessRepository) error {
+	store, ok := ar.(migrations.SchemaStore)
+	if !ok || config.SchemaMigration == "" || config.SchemaMigration == "none" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), schemaMigrationTimeout)
+	defer cancel()
+
+	migrator := migrations.NewMigrator(store)
+	if config.SchemaMigration == "check" {
+		return migrator.Check(ctx)
+	}
+
+	applied, err := migrator.Migrate(ctx)
+	for _, version := range applied {
+		glog.Infof("Migrated store schema to version %s", version)
+	}
+	return err
+}
@@ -4,11 +4,13 @@ import (
 	"authz/bootstrap/serviceconfig"
 	"authz/domain/contracts"
 	"authz/infrastructure/repository/authzed"
+	"authz/infrastructure/repository/memory"
 )
 
 // SeatLicenseRepositoryBuilder constructs SeatLicenseRepositories based on the provided configuration
 type SeatLicenseRepositoryBuilder struct {
-	config *serviceconfig.ServiceConfig
+	config      *serviceconfig.ServiceConfig
+	memoryStore *memory.InMemoryAccessRepository
 }
 
 // NewSeatLicenseRepositoryBuilder constructs a new SeatLicenseRepositoryBuilder
@@ -22,19 +24,35 @@ func (b *SeatLicenseRepositoryBuilder) WithConfig(config *serviceconfig.ServiceC
 	return b
 }
 
+// WithMemoryStore supplies the store to use for the "memory" store kind, so that it can be shared with other repositories. Without one, a new empty store is built.
+func (b *SeatLicenseRepositoryBuilder) WithMemoryStore(store *memory.InMemoryAccessRepository) *SeatLicenseRepositoryBuilder {
+	b.memoryStore = store
+	return b
+}
+
 // Build constructs the repository
 func (b *SeatLicenseRepositoryBuilder) Build() (contracts.SeatLicenseRepository, error) {
 	config := b.config.StoreConfig
+	outbox := b.config.RecordsLicenseEvents()
 	switch config.Kind {
 	case "spicedb":
-		return createSeatLicenseRepository(config)
+		return createSeatLicenseRepository(config, outbox)
+	case "memory":
+		repo := memoryStoreOrNew(b.memoryStore)
+		if outbox {
+			repo.EnableOutbox(memory.NewInMemoryOutbox())
+		}
+		return repo, nil
 	default:
-		return createSeatLicenseRepository(config)
+		return createSeatLicenseRepository(config, outbox)
 	}
 }
 
-func createSeatLicenseRepository(config serviceconfig.StoreConfig) (contracts.SeatLicenseRepository, error) {
+func createSeatLicenseRepository(config serviceconfig.StoreConfig, outbox bool) (contracts.SeatLicenseRepository, error) {
 	spicedb := authzed.SpiceDbAccessRepository{}
+	if outbox {
+		spicedb.EnableOutbox()
+	}
 	token, err := config.ReadToken()
 	if err != nil {
 		return nil, err
@@ -10,10 +10,14 @@ import (
 
 // ServerBuilder is the builder containing the config for building technical implementations of the server
 type ServerBuilder struct {
-	PrincipalRepository contracts.PrincipalRepository
-	AccessAppService    *application.AccessAppService
-	LicenseAppService   *application.LicenseAppService
-	ServiceConfig       *serviceconfig.ServiceConfig
+	PrincipalRepository  contracts.PrincipalRepository
+	AccessAppService     *application.AccessAppService
+	LicenseAppService    *application.LicenseAppService
+	WebhookAppService    *application.WebhookAppService
+	DeadLetterAppService *application.DeadLetterAppService
+	AuditAppService      *application.AuditAppService
+	HealthAppService     *application.HealthAppService
+	ServiceConfig        *serviceconfig.ServiceConfig
 }
 
 // NewServerBuilder returns a new ServerBuilder instance
@@ -33,6 +37,30 @@ func (s *ServerBuilder) WithLicenseAppService(sh *application.LicenseAppService)
 	return s
 }
 
+// WithWebhookAppService sets the WebhookAppService for the server, nil if webhooks are disabled
+func (s *ServerBuilder) WithWebhookAppService(wh *application.WebhookAppService) *ServerBuilder {
+	s.WebhookAppService = wh
+	return s
+}
+
+// WithDeadLetterAppService sets the DeadLetterAppService for the server, nil if the UMB is disabled
+func (s *ServerBuilder) WithDeadLetterAppService(dh *application.DeadLetterAppService) *ServerBuilder {
+	s.DeadLetterAppService = dh
+	return s
+}
+
+// WithAuditAppService sets the AuditAppService for the server, nil if the audit log cannot be queried
+func (s *ServerBuilder) WithAuditAppService(ah *application.AuditAppService) *ServerBuilder {
+	s.AuditAppService = ah
+	return s
+}
+
+// WithHealthAppService sets the HealthAppService probing the dependencies of the server
+func (s *ServerBuilder) WithHealthAppService(hh *application.HealthAppService) *ServerBuilder {
+	s.HealthAppService = hh
+	return s
+}
+
 // WithServiceConfig sets the ServiceConfig configuration for the used server.
 func (s *ServerBuilder) WithServiceConfig(c *serviceconfig.ServiceConfig) *ServerBuilder {
 	s.ServiceConfig = c
@@ -41,7 +69,12 @@ func (s *ServerBuilder) WithServiceConfig(c *serviceconfig.ServiceConfig) *Serve
 
 // BuildGrpc builds the grpc-server of the grpc gateway
 func (s *ServerBuilder) BuildGrpc() (srv *grpc.Server, err error) {
-	return grpc.NewServer(*s.AccessAppService, *s.LicenseAppService, *s.ServiceConfig), nil
+	srv = grpc.NewServer(*s.AccessAppService, *s.LicenseAppService, *s.ServiceConfig)
+	srv.WebhookAppService = s.WebhookAppService
+	srv.DeadLetterAppService = s.DeadLetterAppService
+	srv.AuditAppService = s.AuditAppService
+	srv.HealthAppService = s.HealthAppService
+	return srv, nil
 }
 
 // BuildHTTP builds the HTTP Server of the grpc gateway
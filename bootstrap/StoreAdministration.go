@@ -0,0 +1,61 @@
+package bootstrap
+
+import (
+	"authz/application"
+	"authz/bootstrap/serviceconfig"
+	"authz/domain"
+	"authz/domain/contracts"
+	"authz/infrastructure/repository/authzed/migrations"
+	"authz/infrastructure/snapshot"
+	"errors"
+	"fmt"
+)
+
+// NewSchemaMigrator creates a migrations.Migrator for the schema of the SpiceDB store configured in the config file
+func NewSchemaMigrator(configPath string) (*migrations.Migrator, error) {
+	ar, storeCfg, err := openConfiguredStore(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	store, ok := ar.(migrations.SchemaStore)
+	if !ok {
+		return nil, fmt.Errorf("the %s store has no schema to migrate", storeCfg.Kind)
+	}
+
+	return migrations.NewMigrator(store), nil
+}
+
+// NewSnapshotStore creates a snapshot.Store for the relationships of the store configured in the config file
+func NewSnapshotStore(configPath string) (snapshot.Store, error) {
+	ar, storeCfg, err := openConfiguredStore(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	store, ok := ar.(snapshot.Store)
+	if !ok {
+		return nil, fmt.Errorf("the %s store does not support snapshots", storeCfg.Kind)
+	}
+
+	return store, nil
+}
+
+// openConfiguredStore connects to the store configured in the config file, without starting the service
+func openConfiguredStore(configPath string) (contracts.AccessRepository, serviceconfig.StoreConfig, error) {
+	srvCfg, err := getConfig(configPath)
+	if err != nil {
+		return nil, srvCfg.StoreConfig, err
+	}
+
+	if err = application.ValidateStruct(srvCfg.StoreConfig); err != nil {
+		var inputErr domain.ErrInvalidRequest
+		if errors.As(err, &inputErr) {
+			return nil, srvCfg.StoreConfig, fmt.Errorf("error(s) in store configuration: %s", inputErr.Reason)
+		}
+		return nil, srvCfg.StoreConfig, err
+	}
+
+	ar, err := initAccessRepository(&srvCfg, nil)
+	return ar, srvCfg.StoreConfig, err
+}
@@ -9,8 +9,18 @@ import (
 	"authz/bootstrap/serviceconfig"
 	"authz/domain"
 	"authz/domain/contracts"
+	"authz/infrastructure/repository/audit"
+	"authz/infrastructure/repository/filestore"
+	"authz/infrastructure/repository/memory"
 	"authz/infrastructure/repository/messaging"
+	"authz/infrastructure/repository/webhook"
+	"authz/infrastructure/tracing"
+	"context"
+	"errors"
+	"fmt"
+	"os"
 	"sync"
+	"time"
 
 	"github.com/golang/glog"
 )
@@ -19,6 +29,11 @@ import (
 var grpcServer *grpc.Server
 var httpServer *http.Server
 var eventAdapter *events.EventAdapter
+var outboxDispatcher *events.OutboxDispatcher
+var webhookDispatcher *events.WebhookDispatcher
+var seatReclamationSweeper *events.SeatReclamationSweeper
+var shutdownTracing func(context.Context) error
+var auditLog *audit.JSONLinesAuditLog
 var waitForCompletion *sync.WaitGroup
 
 // getConfig loads the config based on the technical implementation "viper".
@@ -48,8 +63,9 @@ func getConfig(configPath string) (serviceconfig.ServiceConfig, error) {
 				Debug:            false,
 			},
 			StoreConfig: serviceconfig.StoreConfig{
-				Kind:   "spicedb",
-				UseTLS: true,
+				Kind:            "spicedb",
+				UseTLS:          true,
+				SchemaMigration: "none",
 			},
 			TLSConfig: serviceconfig.TLSConfig{
 				CertFile: "/etc/tls/tls.crt",
@@ -57,8 +73,28 @@ func getConfig(configPath string) (serviceconfig.ServiceConfig, error) {
 			},
 			LogRequests: false,
 			UMBConfig: serviceconfig.UMBConfig{
-				RetryBackoffSeconds:   30,
-				ConnectTimeoutSeconds: 30,
+				RetryBackoffSeconds:       30,
+				ConnectTimeoutSeconds:     30,
+				OutboxPollIntervalSeconds: 5,
+				MaxDeliveryAttempts:       5,
+			},
+			LicenseConfig: serviceconfig.LicenseConfig{
+				DowngradePolicy:                "reject",
+				SeatReclamationIntervalSeconds: 3600,
+			},
+			WebhookConfig: serviceconfig.WebhookConfig{
+				MaxAttempts:           5,
+				InitialBackoffSeconds: 1,
+				TimeoutSeconds:        10,
+				DeliveryLogSize:       100,
+			},
+			TracingConfig: serviceconfig.TracingConfig{
+				Exporter:    "none",
+				SampleRatio: 1,
+				ServiceName: "authz",
+			},
+			AuditConfig: serviceconfig.AuditConfig{
+				Sink: "none",
 			},
 		}).
 		Build()
@@ -91,6 +127,12 @@ func Run(configPath string) {
 		return
 	}
 
+	shutdownTracing, err = tracing.Setup(srvCfg.TracingConfig)
+	if err != nil {
+		glog.Error("Error setting up tracing: ", err)
+		return
+	}
+
 	grpcServer, httpServer, eventAdapter, err = initialize(srvCfg)
 	if err != nil {
 		glog.Error("Error in service initialization: ", err)
@@ -111,6 +153,18 @@ func Run(configPath string) {
 		}
 	}()
 
+	if webhookDispatcher != nil {
+		webhookDispatcher.Start()
+	}
+
+	if outboxDispatcher != nil {
+		outboxDispatcher.Start()
+	}
+
+	if seatReclamationSweeper != nil {
+		seatReclamationSweeper.Start()
+	}
+
 	go func() {
 		err := grpcServer.Serve(wait)
 		if err != nil {
@@ -140,22 +194,57 @@ func Stop() {
 
 	waitForCompletion.Wait()
 
+	if outboxDispatcher != nil {
+		outboxDispatcher.Stop()
+	}
+
+	if webhookDispatcher != nil {
+		webhookDispatcher.Stop()
+	}
+
+	if seatReclamationSweeper != nil {
+		seatReclamationSweeper.Stop()
+	}
+
 	if eventAdapter != nil {
 		eventAdapter.Stop()
 	}
 
+	if shutdownTracing != nil {
+		if err := shutdownTracing(context.Background()); err != nil {
+			glog.Errorf("Error flushing traces: %s", err)
+		}
+	}
+
+	if auditLog != nil {
+		if err := auditLog.Close(); err != nil {
+			glog.Errorf("Error closing audit log: %s", err)
+		}
+	}
+
 	grpcServer = nil
 	httpServer = nil
+	outboxDispatcher = nil
+	webhookDispatcher = nil
+	seatReclamationSweeper = nil
+	shutdownTracing = nil
+	auditLog = nil
 	waitForCompletion = nil
 }
 
 func initialize(srvCfg serviceconfig.ServiceConfig) (*grpc.Server, *http.Server, *events.EventAdapter, error) {
+	// The repositories of the "memory" store kind operate on the same data, which lives as long as this run
+	ms := initMemoryStore(srvCfg.StoreConfig)
 
-	ar, err := initAccessRepository(&srvCfg)
+	ar, err := initAccessRepository(&srvCfg, ms)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
+	if err = initSchema(srvCfg.StoreConfig, ar); err != nil {
+		return nil, nil, nil, err
+	}
+
 	// TODO: The init and builder functions need to be tidied up for SubjectRepository and Principal repository
 	// TODO: The casting acrobatics between the two also needs fixing with an intersection type? or dependency injection
 	stubPr := initStubPrincipalRepository(srvCfg.StoreConfig.Kind)
@@ -170,38 +259,107 @@ func initialize(srvCfg serviceconfig.ServiceConfig) (*grpc.Server, *http.Server,
 		pr = subr.(contracts.PrincipalRepository)
 	}
 
-	sr, err := initSeatRepository(&srvCfg)
+	sr, err := initSeatRepository(&srvCfg, ms)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 	or := sr.(contracts.OrganizationRepository)
 
+	al, err := initAuditLog(srvCfg.AuditConfig)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Mutations are audited to any sink, but only the records of a file can be queried
+	var auditing contracts.AuditLog
+	var als *application.AuditAppService
+	if al != nil {
+		auditing = al
+		if srvCfg.AuditConfig.Sink == "file" {
+			als = application.NewAuditAppService(ar, al)
+		}
+	}
+
 	aas := application.NewAccessAppService(&ar, pr)
-	sas := application.NewLicenseAppService(ar, sr, pr, subr, or)
+	sas := application.NewLicenseAppService(ar, sr, pr, subr, or, auditing)
 
-	srv := initGrpcServer(aas, sas, &srvCfg)
+	// License events recorded in the outbox are published to the UMB and delivered to webhooks, as far as enabled
+	var publishers events.PublisherGroup
 
 	umbCfg := srvCfg.UMBConfig
 	var adapter *events.EventAdapter
+	var das *application.DeadLetterAppService
 	if umbCfg.Enabled {
 		umb := messaging.NewUMBMessageBusRepository(umbCfg)
-		adapter = events.NewEventAdapter(sas, umb)
+		dr, err := initDeadLetterRepository(&srvCfg)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		adapter = events.NewEventAdapter(sas, umb, dr, umbCfg.MaxDeliveryAttempts)
+		das = application.NewDeadLetterAppService(dr, sas)
+		if umbCfg.PublishesLicenseEvents() {
+			publishers = append(publishers, umb)
+		}
 	} else {
 		glog.Info("UMB connectivity not enabled.")
 	}
 
+	webhookCfg := srvCfg.WebhookConfig
+	var was *application.WebhookAppService
+	var webhooks *events.WebhookDispatcher
+	if webhookCfg.Enabled {
+		wr, queue, deliveries, err := initWebhookStores(&srvCfg)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		was = application.NewWebhookAppService(ar, wr, deliveries)
+		webhooks = events.NewWebhookDispatcher(wr, webhook.NewHTTPWebhookSenderFromConfig(webhookCfg), deliveries, queue,
+			webhookCfg.MaxAttempts, time.Second*time.Duration(webhookCfg.InitialBackoffSeconds))
+		publishers = append(publishers, webhooks)
+	}
+
+	// Only one replica dispatches, so that events are published in order and webhooks are not called twice
+	var dispatcher *events.OutboxDispatcher
+	if len(publishers) > 0 {
+		if srvCfg.LicenseConfig.DispatchEvents {
+			dispatcher = events.NewOutboxDispatcher(outboxOf(sr), publishers,
+				time.Second*time.Duration(umbCfg.OutboxPollIntervalSeconds),
+				time.Second*time.Duration(umbCfg.RetryBackoffSeconds))
+		} else {
+			glog.Info("License events are recorded, but not dispatched by this replica.")
+			webhooks = nil
+		}
+	}
+
+	var sweeper *events.SeatReclamationSweeper
+	if srvCfg.LicenseConfig.SeatReclamationIntervalSeconds > 0 {
+		sweeper = events.NewSeatReclamationSweeper(sas, time.Second*time.Duration(srvCfg.LicenseConfig.SeatReclamationIntervalSeconds))
+	}
+
+	has := application.NewHealthAppService(initDependencyProbes(&srvCfg, ar, subr, adapter)...)
+
+	srv := initGrpcServer(aas, sas, was, das, als, has, &srvCfg)
+
 	webSrv := initHTTPServer(&srvCfg)
 	webSrv.SetCheckRef(srv)
 	webSrv.SetSeatRef(srv)
 	grpcServer = srv
+	outboxDispatcher = dispatcher
+	webhookDispatcher = webhooks
+	seatReclamationSweeper = sweeper
+	auditLog = al
 	return srv, webSrv, adapter, nil
 }
 
 // initGrpcServer initializes a new grpc server struct
-func initGrpcServer(aas *application.AccessAppService, sas *application.LicenseAppService, serviceConfig *serviceconfig.ServiceConfig) *grpc.Server {
+func initGrpcServer(aas *application.AccessAppService, sas *application.LicenseAppService, was *application.WebhookAppService, das *application.DeadLetterAppService, als *application.AuditAppService, has *application.HealthAppService, serviceConfig *serviceconfig.ServiceConfig) *grpc.Server {
 	srv, err := NewServerBuilder().
 		WithAccessAppService(aas).
 		WithLicenseAppService(sas).
+		WithWebhookAppService(was).
+		WithDeadLetterAppService(das).
+		WithAuditAppService(als).
+		WithHealthAppService(has).
 		WithServiceConfig(serviceConfig).
 		BuildGrpc()
 
@@ -223,15 +381,113 @@ func initHTTPServer(serviceConfig *serviceconfig.ServiceConfig) *http.Server {
 	return srv
 }
 
-func initSeatRepository(config *serviceconfig.ServiceConfig) (contracts.SeatLicenseRepository, error) {
+// initDependencyProbes returns the probes of the dependencies the readiness of the service depends on: the store, the user service and the UMB connection, as far as they are used
+func initDependencyProbes(config *serviceconfig.ServiceConfig, ar contracts.AccessRepository, subr contracts.SubjectRepository, adapter *events.EventAdapter) []application.DependencyProbe {
+	var probes []application.DependencyProbe
+
+	if store, ok := ar.(application.Pinger); ok {
+		probes = append(probes, application.DependencyProbe{Name: config.StoreConfig.Kind, Probe: store.Ping})
+	}
+
+	// The stub used if the user service is not configured cannot be probed
+	if users, ok := subr.(application.Pinger); ok {
+		probes = append(probes, application.DependencyProbe{Name: "userservice", Probe: users.Ping})
+	}
+
+	if adapter != nil {
+		probes = append(probes, application.DependencyProbe{Name: "umb", Probe: func(context.Context) error {
+			if !adapter.IsConnected() {
+				return errors.New("not connected")
+			}
+			return nil
+		}})
+	}
+
+	return probes
+}
+
+// outboxOf returns the outbox the store records license events in
+func outboxOf(sr contracts.SeatLicenseRepository) contracts.OutboxRepository {
+	if store, ok := sr.(*memory.InMemoryAccessRepository); ok {
+		return store.Outbox()
+	}
+	return sr.(contracts.OutboxRepository)
+}
+
+// initDeadLetterRepository opens the store of dead-lettered subject events. They are kept in memory for the memory store kind, in the state directory otherwise.
+func initDeadLetterRepository(srvCfg *serviceconfig.ServiceConfig) (contracts.DeadLetterRepository, error) {
+	if srvCfg.StoreConfig.Kind == "memory" {
+		return memory.NewInMemoryDeadLetterRepository(), nil
+	}
+
+	dr, err := filestore.NewFileDeadLetterRepository(srvCfg.StoreConfig.StateDir)
+	if err != nil {
+		return nil, fmt.Errorf("error opening the dead letter store: %w", err)
+	}
+
+	return dr, nil
+}
+
+// initWebhookStores opens the stores of the webhooks registered by orgs, of their pending deliveries and of the delivery attempts. All are kept in memory for the memory store kind, in the state directory otherwise.
+func initWebhookStores(srvCfg *serviceconfig.ServiceConfig) (contracts.WebhookRepository, contracts.WebhookDeliveryQueue, contracts.WebhookDeliveryLog, error) {
+	logSize := srvCfg.WebhookConfig.DeliveryLogSize
+	if srvCfg.StoreConfig.Kind == "memory" {
+		return memory.NewInMemoryWebhookRepository(), memory.NewInMemoryWebhookDeliveryQueue(), memory.NewInMemoryWebhookDeliveryLog(logSize), nil
+	}
+
+	key, err := srvCfg.WebhookConfig.ReadSecretKey()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error reading the webhook secret key: %w", err)
+	}
+
+	wr, err := filestore.NewFileWebhookRepository(srvCfg.StoreConfig.StateDir, key)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error opening the webhook store: %w", err)
+	}
+
+	queue, err := filestore.NewFileWebhookDeliveryQueue(srvCfg.StoreConfig.StateDir)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error opening the webhook delivery queue: %w", err)
+	}
+
+	deliveries, err := filestore.NewFileWebhookDeliveryLog(srvCfg.StoreConfig.StateDir, logSize)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error opening the webhook delivery log: %w", err)
+	}
+
+	return wr, queue, deliveries, nil
+}
+
+// initAuditLog opens the configured audit log, nil if auditing is disabled
+func initAuditLog(config serviceconfig.AuditConfig) (*audit.JSONLinesAuditLog, error) {
+	switch config.Sink {
+	case "stdout":
+		return audit.NewJSONLinesAuditLog(os.Stdout), nil
+	case "file":
+		return audit.NewFileAuditLog(config.Path)
+	default:
+		glog.Info("Audit log not enabled.")
+		return nil, nil
+	}
+}
+
+// initMemoryStore creates the store shared by the repositories of the "memory" store kind, nil for other kinds
+func initMemoryStore(config serviceconfig.StoreConfig) *memory.InMemoryAccessRepository {
+	if config.Kind != "memory" {
+		return nil
+	}
+	return memory.NewInMemoryAccessRepository()
+}
+
+func initSeatRepository(config *serviceconfig.ServiceConfig, memoryStore *memory.InMemoryAccessRepository) (contracts.SeatLicenseRepository, error) {
 	b := NewSeatLicenseRepositoryBuilder()
 
-	return b.WithConfig(config).Build()
+	return b.WithConfig(config).WithMemoryStore(memoryStore).Build()
 }
 
-func initAccessRepository(config *serviceconfig.ServiceConfig) (contracts.AccessRepository, error) {
+func initAccessRepository(config *serviceconfig.ServiceConfig, memoryStore *memory.InMemoryAccessRepository) (contracts.AccessRepository, error) {
 	return NewAccessRepositoryBuilder().
-		WithConfig(config).Build()
+		WithConfig(config).WithMemoryStore(memoryStore).Build()
 }
 
 func initCombinedUserServiceSubjectPrincipalRepository(config *serviceconfig.ServiceConfig) (contracts.SubjectRepository, error) {
@@ -4,11 +4,15 @@ import (
 	"authz/domain"
 	"authz/infrastructure/repository/authzed"
 	"authz/testenv"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -29,7 +33,6 @@ const (
 var container *authzed.LocalSpiceDbContainer
 
 func TestCheckErrorsWhenCallerNotAuthorized(t *testing.T) {
-	t.SkipNow() //Skip until Check meta-authz is in place
 	setupService(nil)
 	defer teardownService()
 
@@ -89,6 +92,44 @@ func TestCheckReturnsFalseWhenUserNotAuthorized(t *testing.T) {
 	assertJSONResponse(t, resp, 200, `{"result": %t, "description": ""}`, false)
 }
 
+func TestCheckPermissionsReturnsResultPerCheck(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+	resp, err := http.DefaultClient.Do(post("/v1alpha/checks", "checker", "no_particular_org", false, `{"checks": [
+			{"subject": "u1", "operation": "access", "resourcetype": "license", "resourceid": "o1/smarts"},
+			{"subject": "not_authorized", "operation": "access", "resourcetype": "license", "resourceid": "o1/smarts"},
+			{"subject": "u1", "operation": "delete", "resourcetype": "license", "resourceid": "o1/smarts"}
+		]}`))
+
+	assert.NoError(t, err)
+
+	assertJSONResponse(t, resp, 200, `{"results": [
+		{"result": true, "description": "", "error": ""},
+		{"result": false, "description": "", "error": ""},
+		{"result": false, "description": "", "error": "<<PRESENCE>>"}
+	]}`)
+}
+
+func TestCheckPermissionsFailsWithoutChecks(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+	resp, err := http.DefaultClient.Do(post("/v1alpha/checks", "checker", "no_particular_org", false, `{"checks": []}`))
+
+	assert.NoError(t, err)
+
+	assert.Equal(t, 400, resp.StatusCode)
+}
+
+func TestCheckPermissionsFailsWhenUnauthorized(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+	resp, err := http.DefaultClient.Do(post("/v1alpha/checks", "unauthorized_subject", "no_particular_org", false, `{"checks": [{"subject": "u1", "operation": "access", "resourcetype": "license", "resourceid": "o1/smarts"}]}`))
+
+	assert.NoError(t, err)
+
+	assert.Equal(t, 403, resp.StatusCode)
+}
+
 func TestAssignSeatReturnsSuccess(t *testing.T) {
 	setupService(nil)
 	defer teardownService()
@@ -107,7 +148,7 @@ func TestAssignSeatReturnsFailureWhenOrgIsUnauthorized(t *testing.T) {
 	setupService(nil)
 	defer teardownService()
 	// OrgID in request path and in the token are different
-	resp, err := http.DefaultClient.Do(post("/v1alpha/orgs/o1/licenses/smarts", "system", "o2", true, `{
+	resp, err := http.DefaultClient.Do(post("/v1alpha/orgs/o1/licenses/smarts", "u2", "o2", true, `{
 			"assign": [
 			  "u7"
 			]
@@ -121,7 +162,7 @@ func TestAssignSeatReturnsFailureWhenOrgIsUnauthorized(t *testing.T) {
 func TestAssignSeatReturnsFailureWhenNotAuthorizedOrgAdmin(t *testing.T) {
 	setupService(nil)
 	defer teardownService()
-	resp, err := http.DefaultClient.Do(post("/v1alpha/orgs/o1/licenses/smarts", "system", "o1", false, `{
+	resp, err := http.DefaultClient.Do(post("/v1alpha/orgs/o1/licenses/smarts", "u2", "o1", false, `{
 			"assign": [
 			  "u7"
 			]
@@ -132,6 +173,21 @@ func TestAssignSeatReturnsFailureWhenNotAuthorizedOrgAdmin(t *testing.T) {
 	assert.Equal(t, 403, resp.StatusCode)
 }
 
+func TestAssignSeatReturnsSuccessWhenStoreGrantsManageLicense(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+	// okay is an admin of o1 in the store, see schema/spicedb_bootstrap_relations.yaml
+	resp, err := http.DefaultClient.Do(post("/v1alpha/orgs/o1/licenses/smarts", "okay", "no_particular_org", false, `{
+			"assign": [
+			  "u7"
+			]
+		  }`))
+
+	assert.NoError(t, err)
+
+	assertJSONResponse(t, resp, 200, `{}`)
+}
+
 func TestUnassignLicenseReturnsSuccess(t *testing.T) {
 	setupService(nil)
 	defer teardownService()
@@ -177,7 +233,7 @@ func TestEntitleOrgSucceedsWithNewOrgAndNewServiceLicense(t *testing.T) {
 
 	resp2, err := http.DefaultClient.Do(get("/v1alpha/orgs/o3/licenses/foobar", "system", "o3", true))
 	assert.NoError(t, err)
-	assertJSONResponse(t, resp2, 200, `{"seatsAvailable":"25", "seatsTotal": "25"}`)
+	assertJSONResponse(t, resp2, 200, `{"seatsAvailable":"25", "seatsTotal": "25", "startDate": null, "endDate": null, "reclaimDisabledSeats": false}`)
 
 	container.WaitForQuantizationInterval()
 
@@ -185,7 +241,7 @@ func TestEntitleOrgSucceedsWithNewOrgAndNewServiceLicense(t *testing.T) {
 	resp3, err := http.DefaultClient.Do(get("/v1alpha/orgs/o3/licenses/foobar/seats?filter=assignable", "system", "o3", true))
 	assert.NoError(t, err)
 	// 3rd one is disabled, so remove from expected.
-	assertJSONResponse(t, resp3, 200, `{"users": ["<<UNORDERED>>", {"assigned":false,"displayName":"User 1","firstName":"User","lastName":"1","username":"user_1","id":"1"}, {"displayName":"User 2","id":"2","firstName":"User","lastName":"2","username":"user_2","assigned":false}]}`)
+	assertJSONResponse(t, resp3, 200, `{"users": ["<<UNORDERED>>", {"assigned":false,"displayName":"User 1","firstName":"User","lastName":"1","username":"user_1","id":"1"}, {"displayName":"User 2","id":"2","firstName":"User","lastName":"2","username":"user_2","assigned":false}], "nextPageToken": ""}`)
 }
 
 func TestEntitleOrgFailsWithUnAuthorizedRequestor(t *testing.T) {
@@ -359,7 +415,7 @@ func TestEntitleOrgSucceedstWithExistingOrgAndNewLicenses(t *testing.T) {
 	assert.NoError(t, err)
 	resp2, err := http.DefaultClient.Do(get("/v1alpha/orgs/o2/licenses/bazbar", "system", "o2", true))
 	assert.NoError(t, err)
-	assertJSONResponse(t, resp2, 200, `{"seatsAvailable":"20", "seatsTotal": "20"}`)
+	assertJSONResponse(t, resp2, 200, `{"seatsAvailable":"20", "seatsTotal": "20", "startDate": null, "endDate": null, "reclaimDisabledSeats": false}`)
 }
 
 func TestEntitleOrgTriggersUserImportWhenOrgExistsButHasNoUsersImportedYet(t *testing.T) {
@@ -393,14 +449,14 @@ func TestEntitleOrgTriggersUserImportWhenOrgExistsButHasNoUsersImportedYet(t *te
 
 	resp2, err := http.DefaultClient.Do(get("/v1alpha/orgs/"+expectedOrg+"/licenses/foo", "system", "oNoUsers", true))
 	assert.NoError(t, err)
-	assertJSONResponse(t, resp2, 200, `{"seatsAvailable":"25", "seatsTotal": "25"}`)
+	assertJSONResponse(t, resp2, 200, `{"seatsAvailable":"25", "seatsTotal": "25", "startDate": null, "endDate": null, "reclaimDisabledSeats": false}`)
 
 	container.WaitForQuantizationInterval()
 	//round trip: check users were imported and are assignable.
 	resp3, err := http.DefaultClient.Do(get("/v1alpha/orgs/"+expectedOrg+"/licenses/foo/seats?filter=assignable", "system", "oNoUsers", true))
 	assert.NoError(t, err)
 	// 3rd one is disabled, so remove from expected.
-	assertJSONResponse(t, resp3, 200, `{"users": ["<<UNORDERED>>", {"assigned":false,"displayName":"User 1","id":"1","firstName":"User","id":"1","lastName":"1","username":"user_1"}, {"displayName":"User 2","id":"2","assigned":false,"firstName":"User","id":"2","lastName":"2","username":"user_2"}]}`)
+	assertJSONResponse(t, resp3, 200, `{"users": ["<<UNORDERED>>", {"assigned":false,"displayName":"User 1","id":"1","firstName":"User","id":"1","lastName":"1","username":"user_1"}, {"displayName":"User 2","id":"2","assigned":false,"firstName":"User","id":"2","lastName":"2","username":"user_2"}], "nextPageToken": ""}`)
 }
 
 func TestEntitleOrgTwiceForSameLicenseFailsWithBadRequest(t *testing.T) {
@@ -415,7 +471,7 @@ func TestEntitleOrgTwiceForSameLicenseFailsWithBadRequest(t *testing.T) {
 
 	resp2, err := http.DefaultClient.Do(get("/v1alpha/orgs/o3/licenses/foobar", "system", "o3", true))
 	assert.NoError(t, err)
-	assertJSONResponse(t, resp2, 200, `{"seatsAvailable":"25", "seatsTotal": "25"}`)
+	assertJSONResponse(t, resp2, 200, `{"seatsAvailable":"25", "seatsTotal": "25", "startDate": null, "endDate": null, "reclaimDisabledSeats": false}`)
 
 	resp, err := http.DefaultClient.Do(post("/v1alpha/orgs/o3/entitlements/foobar", "system", "o3", true, `{
 			"maxSeats": 24
@@ -426,7 +482,7 @@ func TestEntitleOrgTwiceForSameLicenseFailsWithBadRequest(t *testing.T) {
 	//to make sure the license didn't get messed up we also assert that the seatcount is the expected one
 	resp4, err := http.DefaultClient.Do(get("/v1alpha/orgs/o3/licenses/foobar", "system", "o3", true))
 	assert.NoError(t, err)
-	assertJSONResponse(t, resp4, 200, `{"seatsAvailable":"25", "seatsTotal": "25"}`)
+	assertJSONResponse(t, resp4, 200, `{"seatsAvailable":"25", "seatsTotal": "25", "startDate": null, "endDate": null, "reclaimDisabledSeats": false}`)
 }
 
 func TestEntitleOrgFailsWithNegativeMaxSeatsValue(t *testing.T) {
@@ -491,11 +547,11 @@ func TestGrantedLicenseAffectsCountsAndDetails(t *testing.T) {
 
 	resp, err := http.DefaultClient.Do(get("/v1alpha/orgs/o1/licenses/smarts", "system", "o1", true))
 	assert.NoError(t, err)
-	assertJSONResponse(t, resp, 200, `{"seatsAvailable":"8", "seatsTotal": "10"}`)
+	assertJSONResponse(t, resp, 200, `{"seatsAvailable":"8", "seatsTotal": "10", "startDate": null, "endDate": null, "reclaimDisabledSeats": false}`)
 
 	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/licenses/smarts/seats", "system", "o1", true))
 	assert.NoError(t, err)
-	assertJSONResponse(t, resp, 200, `{"users": ["<<UNORDERED>>", {"assigned":true,"displayName":"O1 User 1","id":"u1","firstName":"O1","id":"u1","lastName":"User 1","username":"user_1"}, {"displayName":"O1 User 3","id":"u3","assigned":true,"firstName":"O1","id":"u3","lastName":"User 3","username":"user_3"}]}`)
+	assertJSONResponse(t, resp, 200, `{"users": ["<<UNORDERED>>", {"assigned":true,"displayName":"O1 User 1","id":"u1","firstName":"O1","id":"u1","lastName":"User 1","username":"user_1"}, {"displayName":"O1 User 3","id":"u3","assigned":true,"firstName":"O1","id":"u3","lastName":"User 3","username":"user_3"}], "nextPageToken": ""}`)
 
 	//Grant a license
 	resp, err = http.DefaultClient.Do(post("/v1alpha/orgs/o1/licenses/smarts", "okay", "o1", true, `{
@@ -510,15 +566,86 @@ func TestGrantedLicenseAffectsCountsAndDetails(t *testing.T) {
 
 	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/licenses/smarts", "system", "o1", true))
 	assert.NoError(t, err)
-	assertJSONResponse(t, resp, 200, `{"seatsAvailable":"7", "seatsTotal": "10"}`)
+	assertJSONResponse(t, resp, 200, `{"seatsAvailable":"7", "seatsTotal": "10", "startDate": null, "endDate": null, "reclaimDisabledSeats": false}`)
 
 	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/licenses/smarts/seats", "system", "o1", true))
 	assert.NoError(t, err)
-	assertJSONResponse(t, resp, 200, `{"users": "<<PRESENCE>>"}`)
+	assertJSONResponse(t, resp, 200, `{"users": "<<PRESENCE>>", "nextPageToken": ""}`)
 
 	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/licenses/smarts/seats?filter=assignable", "token", "o1", true))
 	assert.NoError(t, err)
-	assertJSONResponse(t, resp, 200, `{"users":"<<PRESENCE>>"}`)
+	assertJSONResponse(t, resp, 200, `{"users":"<<PRESENCE>>", "nextPageToken": ""}`)
+}
+
+func TestListLicensesReturnsAllLicensesOfOrg(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(post("/v1alpha/orgs/o1/entitlements/ansible", "system", "o1", true, `{
+			"maxSeats": "5"
+		}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	container.WaitForQuantizationInterval()
+
+	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/licenses", "system", "o1", true))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{"licenses": [{"serviceId":"ansible","seatsAvailable":"5","seatsTotal":"5","startDate":null,"endDate":null,"reclaimDisabledSeats":false}, {"serviceId":"smarts","seatsAvailable":"8","seatsTotal":"10","startDate":null,"endDate":null,"reclaimDisabledSeats":false}]}`)
+}
+
+func TestListLicensesReturnsNothingForOrgWithoutLicenses(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(get("/v1alpha/orgs/o2/licenses", "system", "o2", true))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{"licenses": []}`)
+}
+
+func TestListLicensesFailsWhenNotAuthorizedOrgAdmin(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(get("/v1alpha/orgs/o1/licenses", "system", "o1", false))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestGetUserLicensesReturnsLicensesWithSeat(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(get("/v1alpha/orgs/o1/users/u1/licenses", "system", "o1", true))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{"licenses": [{"serviceId":"smarts", "assignedAt":null}]}`) //assigned by the bootstrap relations, so the time is unknown
+
+	resp, err = http.DefaultClient.Do(post("/v1alpha/orgs/o1/licenses/smarts", "okay", "o1", true, `{
+			"assign": [
+			  "u7"
+			]
+		  }`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	container.WaitForQuantizationInterval()
+
+	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/users/u7/licenses", "u7", "o1", false))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{"licenses": [{"serviceId":"smarts", "assignedAt":"<<PRESENCE>>"}]}`)
+
+	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/users/u2/licenses", "system", "o1", true))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{"licenses": []}`)
+}
+
+func TestGetUserLicensesFailsForOtherUserWhenNotOrgAdmin(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(get("/v1alpha/orgs/o1/users/u1/licenses", "u2", "o1", false))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 }
 
 func TestOverAssigningLicensesFails(t *testing.T) {
@@ -612,6 +739,153 @@ func TestInvalidRequest(t *testing.T) {
 }
 
 // Test helper methods start
+func TestLicenseAdminCanManageDelegatedLicense(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(put("/v1alpha/orgs/o1/licenses/smarts/admins/u5", "system", "o1", true))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{}`)
+
+	container.WaitForQuantizationInterval()
+
+	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/licenses/smarts", "u5", "o1", false))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{"seatsAvailable":"8","seatsTotal":"10","startDate":null,"endDate":null,"reclaimDisabledSeats":false}`)
+
+	resp, err = http.DefaultClient.Do(post("/v1alpha/orgs/o1/licenses/smarts", "u5", "o1", false, `{"assign": ["u7"]}`))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{}`)
+
+	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/licenses/smarts/seats?includeUsers=false", "u5", "o1", false))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Delegation is limited to the license, not the org
+	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/licenses", "u5", "o1", false))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp, err = http.DefaultClient.Do(del("/v1alpha/orgs/o1/licenses/smarts/admins/u5", "system", "o1", true))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{}`)
+
+	container.WaitForQuantizationInterval()
+
+	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/licenses/smarts", "u5", "o1", false))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestGrantLicenseAdminFailsWhenNotOrgAdmin(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(put("/v1alpha/orgs/o1/licenses/smarts/admins/u5", "u2", "o1", false))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestGrantLicenseAdminFailsForUnknownLicense(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(put("/v1alpha/orgs/o1/licenses/unknown/admins/u5", "system", "o1", true))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestSeatReclamationCanBeEnabledForLicense(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(createRequest(http.MethodPut, "/v1alpha/orgs/o1/licenses/smarts/seatreclamation", testenv.CreateToken("u2", "o1", false), `{"enabled": true}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp, err = http.DefaultClient.Do(createRequest(http.MethodPut, "/v1alpha/orgs/o1/licenses/smarts/seatreclamation", testenv.CreateToken("okay", "o1", true), `{"enabled": true}`))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{}`)
+
+	container.WaitForQuantizationInterval()
+
+	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/licenses/smarts", "okay", "o1", true))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{"seatsAvailable":"8","seatsTotal":"10","startDate":null,"endDate":null,"reclaimDisabledSeats":true}`)
+
+	resp, err = http.DefaultClient.Do(createRequest(http.MethodPut, "/v1alpha/orgs/o1/licenses/unknown/seatreclamation", testenv.CreateToken("okay", "o1", true), `{"enabled": true}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestWebhookCanBeRegisteredListedAndDeleted(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(post("/v1alpha/orgs/o1/webhooks", "system", "o1", true, `{"serviceId": "smarts", "url": "https://example.com/hook", "secret": "0123456789abcdef"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var created struct {
+		Webhook struct {
+			ID string `json:"id"`
+		} `json:"webhook"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+	assert.NotEmpty(t, created.Webhook.ID)
+
+	container.WaitForQuantizationInterval()
+
+	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/webhooks", "system", "o1", true))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{"webhooks":[{"id":"%s","serviceId":"smarts","url":"https://example.com/hook"}]}`, created.Webhook.ID)
+
+	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/webhooks/"+created.Webhook.ID+"/deliveries", "system", "o1", true))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{"deliveries":[]}`)
+
+	// Webhooks are only visible to their org
+	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o2/webhooks/"+created.Webhook.ID+"/deliveries", "system", "o2", true))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.DefaultClient.Do(del("/v1alpha/orgs/o1/webhooks/"+created.Webhook.ID, "system", "o1", true))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{}`)
+
+	container.WaitForQuantizationInterval()
+
+	resp, err = http.DefaultClient.Do(get("/v1alpha/orgs/o1/webhooks", "system", "o1", true))
+	assert.NoError(t, err)
+	assertJSONResponse(t, resp, 200, `{"webhooks":[]}`)
+}
+
+func TestCreateWebhookFailsWhenNotOrgAdmin(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(post("/v1alpha/orgs/o1/webhooks", "u2", "o1", false, `{"url": "https://example.com/hook", "secret": "0123456789abcdef"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestCreateWebhookFailsForPlainHTTPURL(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(post("/v1alpha/orgs/o1/webhooks", "system", "o1", true, `{"url": "http://example.com/hook", "secret": "0123456789abcdef"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestDeadLettersAreUnavailableWithoutUMB(t *testing.T) {
+	setupService(nil)
+	defer teardownService()
+
+	resp, err := http.DefaultClient.Do(get("/v1alpha/deadletters", "system", "o1", false))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+}
+
 func assertJSONResponse(t *testing.T, resp *http.Response, statusCode int, template string, args ...interface{}) {
 	if assert.NotNil(t, resp) {
 		assert.Equal(t, statusCode, resp.StatusCode)
@@ -635,6 +909,28 @@ func get(relativeURI string, subject string, orgID string, isOrgAdmin bool) *htt
 	return createRequest(http.MethodGet, relativeURI, token, "")
 }
 
+func put(relativeURI string, subject string, orgID string, isOrgAdmin bool) *http.Request {
+	var token string
+	if subject == "" {
+		token = ""
+	} else {
+		token = testenv.CreateToken(subject, orgID, isOrgAdmin)
+	}
+
+	return createRequest(http.MethodPut, relativeURI, token, "")
+}
+
+func del(relativeURI string, subject string, orgID string, isOrgAdmin bool) *http.Request {
+	var token string
+	if subject == "" {
+		token = ""
+	} else {
+		token = testenv.CreateToken(subject, orgID, isOrgAdmin)
+	}
+
+	return createRequest(http.MethodDelete, relativeURI, token, "")
+}
+
 func post(relativeURI string, subject string, orgID string, isOrgAdmin bool, body string) *http.Request {
 	var token string
 	if subject == "" {
@@ -713,6 +1009,7 @@ func writeTestEnvToYaml(token string, userService *testenv.FakeUserServiceAPI) {
 	storeKey := yml["store"].(map[string]interface{})
 	storeKey["tokenFile"] = tempSecretFile.Name()
 	storeKey["endpoint"] = "localhost:" + container.Port()
+	storeKey["stateDir"] = filepath.Join(temporarySecretDirectory, "state")
 
 	authKey := yml["auth"].([]interface{})[0].(map[string]interface{})
 
@@ -738,6 +1035,13 @@ func writeTestEnvToYaml(token string, userService *testenv.FakeUserServiceAPI) {
 	authzKey["checkAllowlist"] = []string{"checker"}
 	authzKey["licenseImportAllowlist"] = []string{"system"}
 
+	webhookKeyFile := filepath.Join(temporarySecretDirectory, "webhook-key")
+	err = os.WriteFile(webhookKeyFile, []byte(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))), 0600)
+	if err != nil {
+		panic(err)
+	}
+	yml["webhooks"] = map[string]interface{}{"enabled": true, "secretKeyFile": webhookKeyFile}
+
 	if userService != nil {
 		userServiceKey := yml["userservice"].(map[string]interface{})
 		userServiceKey["url"] = userService.URI
@@ -2,7 +2,10 @@
 package serviceconfig
 
 import (
+	"encoding/base64"
+	"fmt"
 	"os"
+	"strings"
 )
 
 const (
@@ -27,9 +30,18 @@ type ServiceConfig struct {
 	AuthzConfig       AuthzConfig       `mapstructure:"authz"`
 	UserServiceConfig UserServiceConfig `mapstructure:"userservice"`
 	UMBConfig         UMBConfig         `mapstructure:"umb"`
+	LicenseConfig     LicenseConfig     `mapstructure:"license"`
+	WebhookConfig     WebhookConfig     `mapstructure:"webhooks"`
+	TracingConfig     TracingConfig     `mapstructure:"tracing"`
+	AuditConfig       AuditConfig       `mapstructure:"audit"`
 	LogRequests       bool
 }
 
+// RecordsLicenseEvents returns true if license events are recorded in the outbox of the store for publishing to the message bus or webhooks
+func (c ServiceConfig) RecordsLicenseEvents() bool {
+	return c.UMBConfig.PublishesLicenseEvents() || c.WebhookConfig.Enabled
+}
+
 // TLSConfig includes the TLS configuration.
 type TLSConfig struct {
 	CertFile string
@@ -42,6 +54,10 @@ type StoreConfig struct {
 	Endpoint  string
 	TokenFile string
 	UseTLS    bool
+	// SchemaMigration is what happens to the SpiceDB schema at startup: none leaves it alone, check refuses to start unless it is the latest version, migrate applies pending migrations first
+	SchemaMigration string `validate:"in=none+check+migrate"`
+	// StateDir is the directory of the documents kept next to the spicedb store: webhooks, their pending deliveries, recent delivery attempts and dead letters. Replicas must share it. Required for the spicedb kind if webhooks or the UMB are enabled.
+	StateDir string
 }
 
 // ReadToken reads token from the TokenFile
@@ -76,6 +92,16 @@ type AuthConfig struct {
 type AuthzConfig struct {
 	CheckAllowList         []string
 	LicenseImportAllowlist []string
+	DeadLetterAllowlist    []string
+}
+
+// LicenseConfig holds the configuration for license management
+type LicenseConfig struct {
+	DowngradePolicy string `validate:"in=reject+report"`
+	// SeatReclamationIntervalSeconds is how often the seats of disabled users are reclaimed in licenses with seat reclamation enabled, zero disables the periodic reclamation
+	SeatReclamationIntervalSeconds int `validate:"gte=0"`
+	// DispatchEvents makes this replica publish the license events recorded in the outbox to the UMB and deliver them to webhooks. It must be enabled on exactly one replica.
+	DispatchEvents bool
 }
 
 // UserServiceConfig holds the configuration to connect to a user service API
@@ -94,6 +120,57 @@ type UMBConfig struct {
 	UMBClientCertFile     string
 	UMBClientCertKey      string
 	TopicName             string
+	LicenseEventsTopic    string // optional, license events are only published if set
 	ConnectTimeoutSeconds int
 	RetryBackoffSeconds   int
+	// OutboxPollIntervalSeconds is how often pending license events are published, failed attempts are retried with backoff up to RetryBackoffSeconds
+	OutboxPollIntervalSeconds int
+	// MaxDeliveryAttempts is how often a subject event is delivered before it is stored as a dead letter and removed from the topic
+	MaxDeliveryAttempts int `validate:"omitempty,gte=1"`
+}
+
+// PublishesLicenseEvents returns true if license events are recorded and published to the message bus
+func (c UMBConfig) PublishesLicenseEvents() bool {
+	return c.Enabled && c.LicenseEventsTopic != ""
+}
+
+// WebhookConfig holds the configuration for delivering license events to the webhooks registered by organizations
+type WebhookConfig struct {
+	Enabled               bool
+	MaxAttempts           int `validate:"omitempty,gte=1"` // attempts per delivery before giving up
+	InitialBackoffSeconds int // delay after the first failed attempt, doubled after each further one
+	TimeoutSeconds        int // timeout of a single attempt
+	DeliveryLogSize       int `validate:"omitempty,gte=1"` // number of recent attempts kept per webhook
+	// SecretKeyFile is the path to a file containing the base64 encoded 32 byte key encrypting the secrets of webhooks kept in the state directory of the store. Required for the spicedb store kind.
+	SecretKeyFile string
+}
+
+// ReadSecretKey reads the key encrypting webhook secrets from the SecretKeyFile
+func (c WebhookConfig) ReadSecretKey() ([]byte, error) {
+	encoded, err := os.ReadFile(c.SecretKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook secret key in %s: %w", c.SecretKeyFile, err)
+	}
+
+	return key, nil
+}
+
+// TracingConfig holds the configuration for exporting OpenTelemetry traces
+type TracingConfig struct {
+	Exporter    string  `validate:"in=none+stdout+otlp"` // none disables tracing, stdout writes spans to the standard output, otlp sends them to an OTLP gRPC receiver
+	Endpoint    string  // optional, host:port of the OTLP receiver, defaults to the OTEL_EXPORTER_OTLP_ENDPOINT environment variable or localhost:4317
+	Insecure    bool    // connect to the OTLP receiver without TLS
+	SampleRatio float64 `validate:"gte=0,lte=1"` // fraction of traces sampled, unless the caller already decided whether to sample
+	ServiceName string
+}
+
+// AuditConfig holds the configuration of the audit log of license mutations
+type AuditConfig struct {
+	Sink string `validate:"in=none+stdout+file"`   // none disables the audit log, stdout writes records to the standard output, file appends them to Path and allows querying them
+	Path string `validate:"required_if=Sink file"` // must be shared by all replicas, as queries only return the records of this file
 }
@@ -0,0 +1,46 @@
+package main
+
+import (
+	core "authz/api/gen/v1alpha"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+// newCheckCommand creates the command for checking whether a subject has a permission on a resource
+func newCheckCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "check <subject> <operation> <resourceType> <resourceId>",
+		Short:        "Check whether a subject has a permission on a resource, e.g. check u1 access license o1/smarts",
+		Args:         cobra.ExactArgs(4),
+		RunE:         checkPermission,
+		SilenceUsage: true, // errors are reported by the service, not caused by the usage
+	}
+	addClientFlags(cmd)
+
+	return cmd
+}
+
+func checkPermission(cmd *cobra.Command, args []string) error {
+	conn, ctx, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := core.NewCheckPermissionClient(conn).CheckPermission(ctx, &core.CheckPermissionRequest{
+		Subject:      args[0],
+		Operation:    args[1],
+		Resourcetype: args[2],
+		Resourceid:   args[3],
+	})
+	if err != nil {
+		return err
+	}
+
+	return printResponse(cmd, resp, func(w io.Writer) {
+		fmt.Fprintln(w, "SUBJECT\tOPERATION\tRESOURCE\tRESULT")
+		fmt.Fprintf(w, "%s\t%s\t%s/%s\t%t\n", args[0], args[1], args[2], args[3], resp.Result)
+	})
+}
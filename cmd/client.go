@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// tokenEnvVar is the environment variable holding the bearer token if the --token flag is not given
+const tokenEnvVar = "AUTHZ_TOKEN"
+
+// The formats the responses of the authz service are printed in
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// addClientFlags adds the flags for connecting to a running authz service to a command and its subcommands
+func addClientFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("server", "localhost:50051", "gRPC address of the authz service")
+	cmd.PersistentFlags().String("token", "", "bearer token of the requestor. Defaults to $"+tokenEnvVar)
+	cmd.PersistentFlags().Bool("plaintext", false, "connect without TLS")
+	cmd.PersistentFlags().StringP("output", "o", outputTable, "output format, "+outputTable+" or "+outputJSON)
+}
+
+// silenceUsageOfSubcommands stops printing the usage of the subcommands of cmd when they fail, as their errors are reported by the service, not caused by the usage
+func silenceUsageOfSubcommands(cmd *cobra.Command) {
+	for _, sub := range cmd.Commands() {
+		sub.SilenceUsage = true
+		silenceUsageOfSubcommands(sub)
+	}
+}
+
+// dial connects to the authz service given by the client flags. The returned context authenticates requests with the bearer token.
+func dial(cmd *cobra.Command) (*grpc.ClientConn, context.Context, error) {
+	flags := cmd.Flags()
+	if output := mustGetString("output", flags); output != outputTable && output != outputJSON {
+		return nil, nil, fmt.Errorf("unknown output format %q, expected %s or %s", output, outputTable, outputJSON)
+	}
+
+	server := mustGetString("server", flags)
+	token := mustGetString("token", flags)
+	if token == "" {
+		token = os.Getenv(tokenEnvVar)
+	}
+
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if plaintext, _ := flags.GetBool("plaintext"); plaintext {
+		creds = insecure.NewCredentials()
+	}
+
+	conn, err := grpc.Dial(server, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+
+	return conn, ctx, nil
+}
+
+// printResponse prints the response of the authz service as JSON if requested by the output flag, otherwise by printTable as tab separated columns
+func printResponse(cmd *cobra.Command, resp proto.Message, printTable func(w io.Writer)) error {
+	if mustGetString("output", cmd.Flags()) == outputJSON {
+		b, err := protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Marshal(resp)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), string(b))
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	printTable(w)
+	return w.Flush()
+}
+
+// formatTimestamp formats an optional timestamp of a response, "-" if it is not set
+func formatTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "-"
+	}
+
+	return ts.AsTime().Format(time.RFC3339)
+}
+
+// parseTimestamp parses an optional RFC 3339 timestamp given as flag, nil if it is empty
+func parseTimestamp(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp %q, expected RFC 3339, e.g. 2024-01-31T00:00:00Z: %w", value, err)
+	}
+
+	return timestamppb.New(t), nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	core "authz/api/gen/v1alpha"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+// newDeadLettersCommand creates the commands for inspecting and replaying dead-lettered UMB subject events
+func newDeadLettersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deadletters",
+		Short: "Inspect and replay UMB subject events that could not be processed",
+	}
+	addClientFlags(cmd)
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List all dead letters, oldest first",
+		Args:  cobra.NoArgs,
+		RunE:  listDeadLetters,
+	}, &cobra.Command{
+		Use:   "replay <id>",
+		Short: "Process the event of a dead letter again and remove the dead letter if that succeeds",
+		Args:  cobra.ExactArgs(1),
+		RunE:  replayDeadLetter,
+	}, &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Remove a dead letter without processing its event",
+		Args:  cobra.ExactArgs(1),
+		RunE:  deleteDeadLetter,
+	})
+	silenceUsageOfSubcommands(cmd)
+
+	return cmd
+}
+
+func listDeadLetters(cmd *cobra.Command, _ []string) error {
+	conn, ctx, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := core.NewDeadLetterServiceClient(conn).ListDeadLetters(ctx, &core.ListDeadLettersRequest{})
+	if err != nil {
+		return err
+	}
+
+	return printResponse(cmd, resp, func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tTIME\tORG\tSUBJECT\tACTIVE\tDELETED\tATTEMPTS\tREASON")
+		for _, letter := range resp.DeadLetters {
+			subject := letter.SubjectId
+			if subject == "" {
+				subject = fmt.Sprintf("(unparseable: %q)", letter.Payload)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%t\t%d\t%s\n", letter.Id, formatTimestamp(letter.Time), letter.OrgId, subject, letter.Active, letter.Deleted, letter.Attempts, letter.Reason)
+		}
+	})
+}
+
+func replayDeadLetter(cmd *cobra.Command, args []string) error {
+	conn, ctx, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := core.NewDeadLetterServiceClient(conn).ReplayDeadLetter(ctx, &core.ReplayDeadLetterRequest{Id: args[0]})
+	if err != nil {
+		return err
+	}
+
+	return printResponse(cmd, resp, func(w io.Writer) {
+		fmt.Fprintf(w, "Replayed dead letter %s\n", args[0])
+	})
+}
+
+func deleteDeadLetter(cmd *cobra.Command, args []string) error {
+	conn, ctx, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := core.NewDeadLetterServiceClient(conn).DeleteDeadLetter(ctx, &core.DeleteDeadLetterRequest{Id: args[0]})
+	if err != nil {
+		return err
+	}
+
+	return printResponse(cmd, resp, func(w io.Writer) {
+		fmt.Fprintf(w, "Deleted dead letter %s\n", args[0])
+	})
+}
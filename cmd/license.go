@@ -0,0 +1,115 @@
+package main
+
+import (
+	core "authz/api/gen/v1alpha"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+// newLicenseCommand creates the commands for entitling organizations and inspecting their licenses
+func newLicenseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "license",
+		Short: "Entitle organizations to services and inspect their licenses",
+	}
+	addClientFlags(cmd)
+
+	entitle := &cobra.Command{
+		Use:   "entitle <orgId> <serviceId>",
+		Short: "Entitle an organization to a service with a number of seats",
+		Args:  cobra.ExactArgs(2),
+		RunE:  entitleOrg,
+	}
+	entitle.Flags().Int64("seats", 0, "number of seats granted to the organization")
+	entitle.Flags().String("start", "", "optional beginning of the license term as RFC 3339 timestamp. Default: valid immediately")
+	entitle.Flags().String("end", "", "optional end of the license term as RFC 3339 timestamp. Default: does not expire")
+	_ = entitle.MarkFlagRequired("seats")
+
+	cmd.AddCommand(entitle, &cobra.Command{
+		Use:   "get <orgId> <serviceId>",
+		Short: "Show the seats and term of the license of an organization for a service",
+		Args:  cobra.ExactArgs(2),
+		RunE:  getLicense,
+	}, &cobra.Command{
+		Use:   "list <orgId>",
+		Short: "List all licenses of an organization",
+		Args:  cobra.ExactArgs(1),
+		RunE:  listLicenses,
+	})
+	silenceUsageOfSubcommands(cmd)
+
+	return cmd
+}
+
+func entitleOrg(cmd *cobra.Command, args []string) error {
+	seats, _ := cmd.Flags().GetInt64("seats")
+	start, err := parseTimestamp(mustGetString("start", cmd.Flags()))
+	if err != nil {
+		return err
+	}
+	end, err := parseTimestamp(mustGetString("end", cmd.Flags()))
+	if err != nil {
+		return err
+	}
+
+	conn, ctx, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := core.NewLicenseServiceClient(conn).EntitleOrg(ctx, &core.EntitleOrgRequest{
+		OrgId:     args[0],
+		ServiceId: args[1],
+		MaxSeats:  seats,
+		StartDate: start,
+		EndDate:   end,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printResponse(cmd, resp, func(w io.Writer) {
+		fmt.Fprintf(w, "Entitled org %s to %s with %d seats\n", args[0], args[1], seats)
+	})
+}
+
+func getLicense(cmd *cobra.Command, args []string) error {
+	conn, ctx, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := core.NewLicenseServiceClient(conn).GetLicense(ctx, &core.GetLicenseRequest{OrgId: args[0], ServiceId: args[1]})
+	if err != nil {
+		return err
+	}
+
+	return printResponse(cmd, resp, func(w io.Writer) {
+		fmt.Fprintln(w, "SERVICE\tSEATS\tAVAILABLE\tSTART\tEND\tRECLAIM")
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%t\n", args[1], resp.SeatsTotal, resp.SeatsAvailable, formatTimestamp(resp.StartDate), formatTimestamp(resp.EndDate), resp.ReclaimDisabledSeats)
+	})
+}
+
+func listLicenses(cmd *cobra.Command, args []string) error {
+	conn, ctx, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := core.NewLicenseServiceClient(conn).ListLicenses(ctx, &core.ListLicensesRequest{OrgId: args[0]})
+	if err != nil {
+		return err
+	}
+
+	return printResponse(cmd, resp, func(w io.Writer) {
+		fmt.Fprintln(w, "SERVICE\tSEATS\tAVAILABLE\tSTART\tEND\tRECLAIM")
+		for _, license := range resp.Licenses {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%t\n", license.ServiceId, license.SeatsTotal, license.SeatsAvailable, formatTimestamp(license.StartDate), formatTimestamp(license.EndDate), license.ReclaimDisabledSeats)
+		}
+	})
+}
@@ -31,9 +31,10 @@ func main() {
 	}
 
 	rootCmd.PersistentFlags().StringP("config", "c", "", "path to config.yaml")
+	rootCmd.AddCommand(newLicenseCommand(), newSeatsCommand(), newOrgCommand(), newCheckCommand(), newDeadLettersCommand(), newSchemaCommand(), newSnapshotCommand())
 
 	if err := rootCmd.Execute(); err != nil {
-		glog.Fatalf("error running command: %v", err)
+		glog.Exitf("error running command: %v", err)
 	}
 
 }
@@ -0,0 +1,46 @@
+package main
+
+import (
+	core "authz/api/gen/v1alpha"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+// newOrgCommand creates the commands for managing the users of organizations
+func newOrgCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "org",
+		Short: "Manage the users of organizations",
+	}
+	addClientFlags(cmd)
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "import <orgId>",
+		Short: "Import the users of an organization from the user service",
+		Args:  cobra.ExactArgs(1),
+		RunE:  importOrg,
+	})
+	silenceUsageOfSubcommands(cmd)
+
+	return cmd
+}
+
+func importOrg(cmd *cobra.Command, args []string) error {
+	conn, ctx, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := core.NewImportServiceClient(conn).ImportOrg(ctx, &core.ImportOrgRequest{OrgId: args[0]})
+	if err != nil {
+		return err
+	}
+
+	return printResponse(cmd, resp, func(w io.Writer) {
+		fmt.Fprintln(w, "ORG\tIMPORTED\tNOT IMPORTED")
+		fmt.Fprintf(w, "%s\t%d\t%d\n", args[0], resp.ImportedUsersCount, resp.NotImportedUsersCount)
+	})
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"authz/bootstrap"
+	"authz/infrastructure/repository/authzed/migrations"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// newSchemaCommand creates the commands for managing the SpiceDB schema of the store configured by --config
+func newSchemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Manage the SpiceDB schema of the store configured by --config",
+	}
+
+	migrate := &cobra.Command{
+		Use:   "migrate",
+		Short: "Bring the store schema to the version of this build by applying the pending migrations in order",
+		Args:  cobra.NoArgs,
+		RunE:  migrateSchema,
+	}
+	migrate.Flags().Bool("dry-run", false, "only show the pending migrations and the differences to the latest version")
+
+	cmd.AddCommand(migrate)
+	silenceUsageOfSubcommands(cmd)
+
+	return cmd
+}
+
+func migrateSchema(cmd *cobra.Command, _ []string) error {
+	configPath, err := nonEmptyStringFlag("config", cmd.Flags())
+	if err != nil {
+		return err
+	}
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	migrator, err := bootstrap.NewSchemaMigrator(configPath)
+	if err != nil {
+		return err
+	}
+	status, err := migrator.Status(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	latest := migrations.Latest()
+	switch {
+	case status.Version > 0:
+		fmt.Fprintf(out, "Store schema is at version %d, latest is %s\n", status.Version, latest)
+	case len(status.Pending) > 0:
+		fmt.Fprintf(out, "Store has no schema, latest is %s\n", latest)
+	default:
+		fmt.Fprintf(out, "Store schema is no known version, latest is %s\n", latest)
+	}
+	for _, change := range status.Changes {
+		fmt.Fprintf(out, "  %s\n", change)
+	}
+
+	if !status.Compatible {
+		return fmt.Errorf("%w: the store schema lacks or differs in elements of the latest version", migrations.ErrIncompatibleSchema)
+	}
+	if len(status.Pending) == 0 {
+		fmt.Fprintln(out, "Nothing to migrate")
+		return nil
+	}
+
+	if dryRun {
+		for _, version := range status.Pending {
+			fmt.Fprintf(out, "Would apply %s\n", version)
+			for _, definition := range status.Deletes(version) {
+				fmt.Fprintf(out, "  deleting all relationships of %s\n", definition)
+			}
+		}
+		return nil
+	}
+
+	applied, err := migrator.Migrate(cmd.Context())
+	for _, version := range applied {
+		fmt.Fprintf(out, "Applied %s\n", version)
+	}
+	return err
+}
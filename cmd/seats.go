@@ -0,0 +1,118 @@
+package main
+
+import (
+	core "authz/api/gen/v1alpha"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// newSeatsCommand creates the commands for assigning the seats of a license to users
+func newSeatsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seats",
+		Short: "Assign and unassign the seats of a license",
+	}
+	addClientFlags(cmd)
+
+	list := &cobra.Command{
+		Use:   "list <orgId> <serviceId>",
+		Short: "List the users assigned a seat, or the users who could be assigned one",
+		Args:  cobra.ExactArgs(2),
+		RunE:  listSeats,
+	}
+	list.Flags().Bool("assignable", false, "list the users of the organization who are not assigned a seat instead")
+	list.Flags().String("sort", core.SeatSortType_id.String(), "order of the users, one of id, username or displayName")
+	list.Flags().String("search", "", "only list users whose first name, last name or username contains this term, ignoring case")
+	list.Flags().Int32("page-size", 0, "maximum number of users listed, at most 1000. Default: all users")
+	list.Flags().String("page-token", "", "token printed by the previous invocation to list the following page")
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "assign <orgId> <serviceId> <userId>...",
+		Short: "Assign seats of a license to users",
+		Args:  cobra.MinimumNArgs(3),
+		RunE:  assignSeats,
+	}, &cobra.Command{
+		Use:   "unassign <orgId> <serviceId> <userId>...",
+		Short: "Remove users from the seats of a license",
+		Args:  cobra.MinimumNArgs(3),
+		RunE:  unassignSeats,
+	}, list)
+	silenceUsageOfSubcommands(cmd)
+
+	return cmd
+}
+
+func assignSeats(cmd *cobra.Command, args []string) error {
+	return modifySeats(cmd, &core.ModifySeatsRequest{OrgId: args[0], ServiceId: args[1], Assign: args[2:]}, "Assigned")
+}
+
+func unassignSeats(cmd *cobra.Command, args []string) error {
+	return modifySeats(cmd, &core.ModifySeatsRequest{OrgId: args[0], ServiceId: args[1], Unassign: args[2:]}, "Unassigned")
+}
+
+func modifySeats(cmd *cobra.Command, req *core.ModifySeatsRequest, verb string) error {
+	conn, ctx, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := core.NewLicenseServiceClient(conn).ModifySeats(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return printResponse(cmd, resp, func(w io.Writer) {
+		fmt.Fprintf(w, "%s seats of %s in org %s: %s\n", verb, req.ServiceId, req.OrgId, strings.Join(append(req.Assign, req.Unassign...), ", "))
+	})
+}
+
+func listSeats(cmd *cobra.Command, args []string) error {
+	flags := cmd.Flags()
+	req := &core.GetSeatsRequest{OrgId: args[0], ServiceId: args[1]}
+
+	if assignable, _ := flags.GetBool("assignable"); assignable {
+		filter := core.SeatFilterType_assignable
+		req.Filter = &filter
+	}
+	sortBy, ok := core.SeatSortType_value[mustGetString("sort", flags)]
+	if !ok {
+		return fmt.Errorf("unknown sort order %q, expected id, username or displayName", mustGetString("sort", flags))
+	}
+	req.SortBy = core.SeatSortType(sortBy).Enum()
+	if search := mustGetString("search", flags); search != "" {
+		req.Search = &search
+	}
+	if pageSize, _ := flags.GetInt32("page-size"); pageSize > 0 {
+		req.PageSize = &pageSize
+	}
+	if pageToken := mustGetString("page-token", flags); pageToken != "" {
+		req.PageToken = &pageToken
+	}
+
+	conn, ctx, err := dial(cmd)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := core.NewLicenseServiceClient(conn).GetSeats(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	err = printResponse(cmd, resp, func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tUSERNAME\tDISPLAY NAME\tASSIGNED")
+		for _, user := range resp.Users {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%t\n", user.Id, user.Username, user.DisplayName, user.Assigned)
+		}
+	})
+	if err == nil && resp.NextPageToken != "" && mustGetString("output", flags) == outputTable {
+		fmt.Fprintf(cmd.ErrOrStderr(), "More users available, list them with --page-token %s\n", resp.NextPageToken)
+	}
+
+	return err
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"authz/bootstrap"
+	"authz/infrastructure/snapshot"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// newSnapshotCommand creates the commands for exporting and importing the authorization state of the store configured by --config
+func newSnapshotCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Export and import licenses, seat assignments, org memberships and disabled users of the store configured by --config",
+	}
+
+	export := &cobra.Command{
+		Use:   "export [file]",
+		Short: "Write a snapshot of the store to a file, or to the standard output if no file or - is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  exportSnapshot,
+	}
+	export.Flags().String("format", "", "yaml or json. Default: json for .json files, yaml otherwise")
+
+	importCmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Restore a snapshot, or the relationships of a SpiceDB bootstrap file, into the store. Importing the same snapshot again changes nothing.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  importSnapshot,
+	}
+	importCmd.Flags().String("format", "", "yaml or json. Default: json for .json files, yaml otherwise")
+	importCmd.Flags().Bool("dry-run", false, "only print the relationships the import would remove (-) and create (+)")
+
+	cmd.AddCommand(export, importCmd)
+	silenceUsageOfSubcommands(cmd)
+
+	return cmd
+}
+
+func exportSnapshot(cmd *cobra.Command, args []string) error {
+	path := "-"
+	if len(args) > 0 {
+		path = args[0]
+	}
+	format, err := snapshotFormat(cmd, path)
+	if err != nil {
+		return err
+	}
+
+	store, err := openSnapshotStore(cmd)
+	if err != nil {
+		return err
+	}
+
+	exported, err := snapshot.Export(cmd.Context(), store)
+	if err != nil {
+		return err
+	}
+
+	if path == "-" {
+		return exported.Write(cmd.OutOrStdout(), format)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if err = exported.Write(file, format); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	rels, _ := exported.ParseRelationships()
+	fmt.Fprintf(cmd.OutOrStdout(), "Exported %d relationships to %s\n", len(rels), path)
+	return nil
+}
+
+func importSnapshot(cmd *cobra.Command, args []string) error {
+	format, err := snapshotFormat(cmd, args[0])
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	read, err := snapshot.Read(file, format)
+	if err != nil {
+		return fmt.Errorf("reading snapshot %s: %w", args[0], err)
+	}
+
+	store, err := openSnapshotStore(cmd)
+	if err != nil {
+		return err
+	}
+
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		return printSnapshotDiff(cmd, store, read, args[0])
+	}
+
+	result, err := snapshot.Import(cmd.Context(), store, read)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Imported %s: %d relationships created, %d removed\n", args[0], result.Created, result.Removed)
+	return nil
+}
+
+// printSnapshotDiff prints the changes importing the snapshot would make to the store
+func printSnapshotDiff(cmd *cobra.Command, store snapshot.Store, read snapshot.Snapshot, path string) error {
+	changes, err := snapshot.Diff(cmd.Context(), store, read)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	for _, rel := range changes.Remove {
+		fmt.Fprintf(out, "- %s\n", rel)
+	}
+	for _, rel := range changes.Touch {
+		fmt.Fprintf(out, "+ %s\n", rel)
+	}
+	fmt.Fprintf(out, "Importing %s would create %d relationships and remove %d, nothing was changed\n", path, len(changes.Touch), len(changes.Remove))
+	return nil
+}
+
+func openSnapshotStore(cmd *cobra.Command) (snapshot.Store, error) {
+	configPath, err := nonEmptyStringFlag("config", cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+
+	return bootstrap.NewSnapshotStore(configPath)
+}
+
+// snapshotFormat returns the format given by the format flag, or else the format of the file
+func snapshotFormat(cmd *cobra.Command, path string) (snapshot.Format, error) {
+	switch format := snapshot.Format(mustGetString("format", cmd.Flags())); format {
+	case "":
+		return snapshot.FormatOf(path), nil
+	case snapshot.YAML, snapshot.JSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown snapshot format %q, expected %s or %s", format, snapshot.YAML, snapshot.JSON)
+	}
+}
@@ -0,0 +1,7 @@
+package domain
+
+// AuditEvent represents a request to read the audit records of an organization
+type AuditEvent struct {
+	Request
+	Query AuditQuery
+}
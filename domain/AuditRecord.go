@@ -0,0 +1,74 @@
+package domain
+
+import "time"
+
+// AuditOperation names the kind of license mutation an audit record is about
+type AuditOperation string
+
+const (
+	// AuditModifySeats is the assignment and unassignment of seats of a license
+	AuditModifySeats AuditOperation = "ModifySeats"
+	// AuditEntitleOrg is the entitlement of an organization for a service
+	AuditEntitleOrg AuditOperation = "EntitleOrg"
+	// AuditUpdateEntitlement is the change of the number of seats of an existing license
+	AuditUpdateEntitlement AuditOperation = "UpdateEntitlement"
+	// AuditRevokeEntitlement is the removal of a license with all its seat assignments
+	AuditRevokeEntitlement AuditOperation = "RevokeEntitlement"
+	// AuditImportOrg is the import of the users of an organization
+	AuditImportOrg AuditOperation = "ImportOrg"
+	// AuditSubjectEvent is the processing of a subject change received from the message bus
+	AuditSubjectEvent AuditOperation = "SubjectEvent"
+)
+
+// AuditRecord records who changed the licenses or members of an organization, when, and whether the change succeeded
+type AuditRecord struct {
+	Time time.Time
+	// Requestor is the subject who requested the change, empty for subject events from the message bus
+	Requestor SubjectID
+	Operation AuditOperation
+	OrgID     string
+	// ServiceID is the service of the changed license, empty for operations on all licenses of the organization
+	ServiceID  string
+	Assigned   []SubjectID
+	Unassigned []SubjectID
+	// SubjectID is the subject a subject event is about
+	SubjectID SubjectID
+	// Detail describes further parameters or results of the operation, e.g. the number of entitled seats
+	Detail string
+	// Error describes why the operation failed, empty if it succeeded
+	Error string
+}
+
+// Succeeded returns true if the audited operation succeeded
+func (r AuditRecord) Succeeded() bool {
+	return r.Error == ""
+}
+
+// AuditQuery selects the audit records of an organization
+type AuditQuery struct {
+	OrgID string
+	// ServiceID restricts the query to the records about the license of a single service, empty for all records
+	ServiceID string
+	// From restricts the query to records at or after the time, zero for no lower bound
+	From time.Time
+	// To restricts the query to records before the time, zero for no upper bound
+	To time.Time
+	// Limit is the maximum number of records returned, zero for all
+	Limit int
+	// PageToken continues after the page that returned it, empty for the first page
+	PageToken string
+}
+
+// AuditPage contains a page of audit records and the token to request the next page, which is empty on the last page
+type AuditPage struct {
+	Records       []AuditRecord
+	NextPageToken string
+}
+
+// Matches returns true if the record is selected by the query
+func (q AuditQuery) Matches(r AuditRecord) bool {
+	return r.OrgID == q.OrgID &&
+		(q.ServiceID == "" || r.ServiceID == q.ServiceID) &&
+		(q.From.IsZero() || !r.Time.Before(q.From)) &&
+		(q.To.IsZero() || r.Time.Before(q.To))
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditQueryMatchesRecordsOfTheOrg(t *testing.T) {
+	q := AuditQuery{OrgID: "o1"}
+
+	assert.True(t, q.Matches(AuditRecord{OrgID: "o1", ServiceID: "smarts"}))
+	assert.True(t, q.Matches(AuditRecord{OrgID: "o1"}))
+	assert.False(t, q.Matches(AuditRecord{OrgID: "o2", ServiceID: "smarts"}))
+}
+
+func TestAuditQueryMatchesRecordsOfTheService(t *testing.T) {
+	q := AuditQuery{OrgID: "o1", ServiceID: "smarts"}
+
+	assert.True(t, q.Matches(AuditRecord{OrgID: "o1", ServiceID: "smarts"}))
+	assert.False(t, q.Matches(AuditRecord{OrgID: "o1", ServiceID: "media"}))
+	assert.False(t, q.Matches(AuditRecord{OrgID: "o1"}), "Records about all licenses of the org should NOT match a service.")
+}
+
+func TestAuditQueryMatchesRecordsWithinTimeRange(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	q := AuditQuery{OrgID: "o1", From: from, To: to}
+
+	assert.False(t, q.Matches(AuditRecord{OrgID: "o1", Time: from.Add(-time.Second)}))
+	assert.True(t, q.Matches(AuditRecord{OrgID: "o1", Time: from}))
+	assert.True(t, q.Matches(AuditRecord{OrgID: "o1", Time: to.Add(-time.Second)}))
+	assert.False(t, q.Matches(AuditRecord{OrgID: "o1", Time: to}))
+
+	assert.True(t, AuditQuery{OrgID: "o1", From: from}.Matches(AuditRecord{OrgID: "o1", Time: to.Add(time.Hour)}))
+	assert.True(t, AuditQuery{OrgID: "o1", To: to}.Matches(AuditRecord{OrgID: "o1", Time: time.Time{}}))
+}
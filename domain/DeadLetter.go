@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// DeadLetter is a subject event from the message bus that could not be processed and was set aside for inspection and replay
+type DeadLetter struct {
+	// ID orders dead letters by the time they were recorded
+	ID string
+	// SubjectID, OrgID, Active and Deleted are the content of the event, SubjectID and OrgID are empty if the message could not be parsed
+	SubjectID SubjectID
+	OrgID     string
+	Active    bool
+	Deleted   bool
+	// Payload is the raw message, the only content of messages that could not be parsed
+	Payload string
+	// Reason describes why the last attempt to process the event failed
+	Reason   string
+	Attempts int
+	Time     time.Time
+}
+
+// NewDeadLetterID generates a new dead letter ID that sorts after the IDs generated before the given time
+func NewDeadLetterID(t time.Time) string {
+	return newTimeOrderedID(t)
+}
+
+// newTimeOrderedID generates a new random ID that sorts after the IDs generated before the given time
+func newTimeOrderedID(t time.Time) string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("%019d_%s", t.UnixNano(), hex.EncodeToString(b))
+}
+
+// Replayable returns true if the dead letter contains an event that can be processed again
+func (d DeadLetter) Replayable() bool {
+	return d.SubjectID != "" && (d.OrgID != "" || d.Deleted)
+}
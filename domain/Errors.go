@@ -30,3 +30,15 @@ var ErrConflict = errors.New("Conflict")
 
 // ErrSubjectAlreadyExists is returned whenever we try to add a subject in OrganizationRepository that already exists
 var ErrSubjectAlreadyExists = errors.New("ErrSubjectAlreadyExists")
+
+// ErrLicenseNotFound is returned when an operation targets a license that does not exist
+var ErrLicenseNotFound = errors.New("LicenseNotFound")
+
+// ErrWebhookNotFound is returned when an operation targets a webhook that does not exist
+var ErrWebhookNotFound = errors.New("WebhookNotFound")
+
+// ErrDeadLetterNotFound is returned when an operation targets a dead letter that does not exist
+var ErrDeadLetterNotFound = errors.New("DeadLetterNotFound")
+
+// ErrAuditLogNotQueryable is returned when reading the records of an audit log that only writes them
+var ErrAuditLogNotQueryable = errors.New("AuditLogNotQueryable")
@@ -2,7 +2,23 @@ package domain
 
 // GetLicenseEvent represents a request for a license
 type GetLicenseEvent struct {
-	Requestor SubjectID
-	OrgID     string
-	ServiceID string
+	Requestor           SubjectID
+	RequestorIsOrgAdmin bool
+	OrgID               string
+	ServiceID           string
+}
+
+// ListLicensesEvent represents a request for all licenses of an organization
+type ListLicensesEvent struct {
+	Requestor           SubjectID
+	RequestorIsOrgAdmin bool
+	OrgID               string
+}
+
+// GetUserLicensesEvent represents a request for the seats a user holds in the licenses of an organization
+type GetUserLicensesEvent struct {
+	Requestor           SubjectID
+	RequestorIsOrgAdmin bool
+	OrgID               string
+	SubjectID           SubjectID
 }
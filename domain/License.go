@@ -1,5 +1,11 @@
 package domain
 
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
 // License represents a license purchased by an org for a service
 type License struct {
 	OrgID     string
@@ -7,8 +13,22 @@ type License struct {
 	MaxSeats  int
 	Version   string
 	InUse     int
+	StartDate time.Time // Beginning of the license term, the zero value means valid since ever
+	EndDate   time.Time // End of the license term, the zero value means valid forever
+	// ReclaimDisabledSeats is true if seats held by disabled subjects are unassigned automatically
+	ReclaimDisabledSeats bool
 }
 
+// DowngradePolicy determines how a reduction of MaxSeats below the number of seats in use is handled
+type DowngradePolicy string
+
+const (
+	// DowngradePolicyReject rejects a change of MaxSeats below the number of seats in use
+	DowngradePolicyReject DowngradePolicy = "reject"
+	// DowngradePolicyReport applies a change of MaxSeats below the number of seats in use and reports the overage
+	DowngradePolicyReport DowngradePolicy = "report"
+)
+
 // NewLicense constructs a new License entity
 func NewLicense(orgID string, serviceID string, maxSeats int, assigned int) *License {
 	return &License{
@@ -19,7 +39,48 @@ func NewLicense(orgID string, serviceID string, maxSeats int, assigned int) *Lic
 	}
 }
 
-// GetAvailableSeats - Get available seats Max - InUSe
+// GetAvailableSeats - Get available seats Max - InUSe, or zero if the license is over-assigned
 func (l *License) GetAvailableSeats() int {
+	if l.InUse > l.MaxSeats {
+		return 0
+	}
 	return l.MaxSeats - l.InUse
 }
+
+// GetOverage - Get the number of seats in use exceeding MaxSeats, e.g. after a downgrade
+func (l *License) GetOverage() int {
+	if l.InUse > l.MaxSeats {
+		return l.InUse - l.MaxSeats
+	}
+	return 0
+}
+
+// AsResource converts the License into a Resource that can be used for access checks
+func (l *License) AsResource() Resource {
+	return Resource{Type: "license", ID: l.OrgID + "/" + l.ServiceID}
+}
+
+// Exists returns true if the license was found in the store, false for the zero license returned for unknown licenses
+func (l *License) Exists() bool {
+	return l.Version != ""
+}
+
+// IsActiveAt returns true if the given point in time is within the term of the license
+func (l *License) IsActiveAt(t time.Time) bool {
+	if !l.StartDate.IsZero() && t.Before(l.StartDate) {
+		return false
+	}
+	if !l.EndDate.IsZero() && !t.Before(l.EndDate) {
+		return false
+	}
+	return true
+}
+
+// NewLicenseVersion generates a new random license version identifier
+func NewLicenseVersion() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
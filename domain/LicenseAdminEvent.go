@@ -0,0 +1,9 @@
+package domain
+
+// LicenseAdminEvent represents a request to grant or revoke the delegated management of the license of an organization for a service
+type LicenseAdminEvent struct {
+	Request
+	Org       Organization
+	Service   Service
+	SubjectID SubjectID
+}
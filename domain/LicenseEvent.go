@@ -0,0 +1,84 @@
+package domain
+
+// LicenseEventType identifies the kind of change a LicenseEvent notifies about
+type LicenseEventType string
+
+const (
+	// SeatAssigned notifies that a subject was assigned a seat in a license
+	SeatAssigned LicenseEventType = "SeatAssigned"
+	// SeatUnassigned notifies that a subject was unassigned from a seat in a license
+	SeatUnassigned LicenseEventType = "SeatUnassigned"
+	// LicenseEntitled notifies that an organization was entitled with a license
+	LicenseEntitled LicenseEventType = "LicenseEntitled"
+	// LicenseUpdated notifies that the seat limit of a license changed
+	LicenseUpdated LicenseEventType = "LicenseUpdated"
+	// LicenseRevoked notifies that a license was removed together with all its seat assignments
+	LicenseRevoked LicenseEventType = "LicenseRevoked"
+)
+
+// LicenseEvent notifies downstream services about a change to a license or its seat assignments
+type LicenseEvent struct {
+	Type      LicenseEventType
+	OrgID     string
+	ServiceID string
+	// SubjectID is the subject who was assigned or unassigned, empty for events of the license itself
+	SubjectID SubjectID
+	// SeatsTotal and SeatsAvailable are the counts of the license after the change
+	SeatsTotal     int
+	SeatsAvailable int
+	// PreviousSeatsTotal is the seat limit before a LicenseUpdated or LicenseRevoked event, 0 for other events
+	PreviousSeatsTotal int
+}
+
+// NewSeatEvents creates a SeatAssigned or SeatUnassigned event per subject for a change to the given license. The license must reflect the counts after the change.
+func NewSeatEvents(license *License, assigned []SubjectID, unassigned []SubjectID) []LicenseEvent {
+	evts := make([]LicenseEvent, 0, len(assigned)+len(unassigned))
+	for _, subj := range unassigned {
+		evts = append(evts, newLicenseEvent(SeatUnassigned, license, subj))
+	}
+	for _, subj := range assigned {
+		evts = append(evts, newLicenseEvent(SeatAssigned, license, subj))
+	}
+
+	return evts
+}
+
+// NewLicenseEntitledEvent creates a LicenseEntitled event for the given license
+func NewLicenseEntitledEvent(license *License) LicenseEvent {
+	return newLicenseEvent(LicenseEntitled, license, "")
+}
+
+// NewLicenseUpdatedEvent creates a LicenseUpdated event for the change of the seat limit of a license from current to updated
+func NewLicenseUpdatedEvent(current *License, updated *License) LicenseEvent {
+	evt := newLicenseEvent(LicenseUpdated, updated, "")
+	evt.PreviousSeatsTotal = current.MaxSeats
+	return evt
+}
+
+// NewLicenseRevokedEvent creates a LicenseRevoked event for the license of an org for a service that had the given seat limit. A revoked license has no seats.
+func NewLicenseRevokedEvent(orgID string, serviceID string, maxSeats int) LicenseEvent {
+	return LicenseEvent{
+		Type:               LicenseRevoked,
+		OrgID:              orgID,
+		ServiceID:          serviceID,
+		PreviousSeatsTotal: maxSeats,
+	}
+}
+
+func newLicenseEvent(eventType LicenseEventType, license *License, subj SubjectID) LicenseEvent {
+	return LicenseEvent{
+		Type:           eventType,
+		OrgID:          license.OrgID,
+		ServiceID:      license.ServiceID,
+		SubjectID:      subj,
+		SeatsTotal:     license.MaxSeats,
+		SeatsAvailable: license.GetAvailableSeats(),
+	}
+}
+
+// OutboxEvent is a LicenseEvent that was recorded together with the change it notifies about and is pending delivery
+type OutboxEvent struct {
+	// ID identifies the event in the outbox, IDs of later events sort after those of earlier ones
+	ID string
+	LicenseEvent
+}
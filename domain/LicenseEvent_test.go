@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeatEventsCarryCountsAfterChange(t *testing.T) {
+	l := NewLicense("o1", "smarts", 10, 3)
+
+	evts := NewSeatEvents(l, []SubjectID{"u2", "u3"}, []SubjectID{"u1"})
+
+	assert.Equal(t, []LicenseEvent{
+		{Type: SeatUnassigned, OrgID: "o1", ServiceID: "smarts", SubjectID: "u1", SeatsTotal: 10, SeatsAvailable: 7},
+		{Type: SeatAssigned, OrgID: "o1", ServiceID: "smarts", SubjectID: "u2", SeatsTotal: 10, SeatsAvailable: 7},
+		{Type: SeatAssigned, OrgID: "o1", ServiceID: "smarts", SubjectID: "u3", SeatsTotal: 10, SeatsAvailable: 7},
+	}, evts)
+}
+
+func TestLicenseEntitledEventHasNoSubject(t *testing.T) {
+	evt := NewLicenseEntitledEvent(NewLicense("o1", "smarts", 5, 0))
+
+	assert.Equal(t, LicenseEvent{Type: LicenseEntitled, OrgID: "o1", ServiceID: "smarts", SeatsTotal: 5, SeatsAvailable: 5}, evt)
+}
+
+func TestLicenseRevokedEventHasNoSeats(t *testing.T) {
+	evt := NewLicenseRevokedEvent("o1", "smarts", 5)
+
+	assert.Equal(t, LicenseEvent{Type: LicenseRevoked, OrgID: "o1", ServiceID: "smarts", PreviousSeatsTotal: 5}, evt)
+}
+
+func TestLicenseUpdatedEventCarriesOldAndNewLimit(t *testing.T) {
+	evt := NewLicenseUpdatedEvent(NewLicense("o1", "smarts", 5, 2), NewLicense("o1", "smarts", 8, 2))
+
+	assert.Equal(t, LicenseEvent{Type: LicenseUpdated, OrgID: "o1", ServiceID: "smarts", SeatsTotal: 8, SeatsAvailable: 6, PreviousSeatsTotal: 5}, evt)
+}
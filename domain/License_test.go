@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLicenseWithoutTermIsAlwaysActive(t *testing.T) {
+	l := NewLicense("o1", "smarts", 10, 0)
+
+	assert.True(t, l.IsActiveAt(time.Time{}))
+	assert.True(t, l.IsActiveAt(time.Now()))
+}
+
+func TestLicenseIsActiveWithinTerm(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := &License{MaxSeats: 10, StartDate: start, EndDate: end}
+
+	assert.False(t, l.IsActiveAt(start.Add(-time.Second)), "Should NOT be active before the start date.")
+	assert.True(t, l.IsActiveAt(start))
+	assert.True(t, l.IsActiveAt(end.Add(-time.Second)))
+	assert.False(t, l.IsActiveAt(end), "Should NOT be active from the end date on.")
+}
+
+func TestLicenseAvailableSeatsAndOverage(t *testing.T) {
+	l := NewLicense("o1", "smarts", 2, 3)
+
+	assert.Equal(t, 0, l.GetAvailableSeats())
+	assert.Equal(t, 1, l.GetOverage())
+
+	l.MaxSeats = 5
+	assert.Equal(t, 2, l.GetAvailableSeats())
+	assert.Equal(t, 0, l.GetOverage())
+}
@@ -8,5 +8,5 @@ type Organization struct {
 
 // AsResource converts the Organization into a Resource that can be used for access checks
 func (o Organization) AsResource() Resource {
-	return Resource{Type: "organization", ID: o.ID}
+	return Resource{Type: "org", ID: o.ID}
 }
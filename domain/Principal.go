@@ -1,5 +1,10 @@
 package domain
 
+import (
+	"sort"
+	"strings"
+)
+
 // A Principal is an identity that may have some authority
 type Principal struct {
 	//IDs are permanent and unique identifying values.
@@ -29,3 +34,44 @@ func NewPrincipal(id SubjectID, firstName string, lastName string, userName stri
 func NewAnonymousPrincipal() Principal {
 	return Principal{ID: ""}
 }
+
+// PrincipalSortOrder names the attribute a list of principals is ordered by
+type PrincipalSortOrder string
+
+const (
+	// PrincipalSortByID orders principals by their IDs
+	PrincipalSortByID PrincipalSortOrder = "id"
+	// PrincipalSortByUsername orders principals by their usernames
+	PrincipalSortByUsername PrincipalSortOrder = "username"
+	// PrincipalSortByDisplayName orders principals by their first and last names
+	PrincipalSortByDisplayName PrincipalSortOrder = "displayName"
+)
+
+// MatchesSearch returns true if the given term is contained in the principal's first name, last name or username, ignoring case. An empty term matches every principal.
+func (p Principal) MatchesSearch(term string) bool {
+	term = strings.ToLower(term)
+
+	return strings.Contains(strings.ToLower(p.FirstName), term) ||
+		strings.Contains(strings.ToLower(p.LastName), term) ||
+		strings.Contains(strings.ToLower(p.Username), term)
+}
+
+// SortKey returns the value principals are ordered by before their IDs in the given sort order, empty when ordering by ID. Comparing keys gives the order of SortPrincipals, ignoring case.
+func (p Principal) SortKey(order PrincipalSortOrder) string {
+	switch order {
+	case PrincipalSortByUsername:
+		return strings.ToLower(p.Username)
+	case PrincipalSortByDisplayName:
+		// The separator sorts before any character, so first names are compared before last names
+		return strings.ToLower(p.FirstName) + "\x00" + strings.ToLower(p.LastName)
+	default:
+		return ""
+	}
+}
+
+// SortPrincipals sorts the given principals in place, breaking ties by ID to keep the order stable across requests.
+func SortPrincipals(principals []Principal, order PrincipalSortOrder) {
+	sort.SliceStable(principals, func(i, j int) bool {
+		return CursorOf(principals[i], order).Before(CursorOf(principals[j], order))
+	})
+}
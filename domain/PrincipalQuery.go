@@ -0,0 +1,52 @@
+package domain
+
+// PrincipalCursor is the position of a principal in a list of principals ordered by a PrincipalSortOrder
+type PrincipalCursor struct {
+	SortKey string
+	ID      SubjectID
+}
+
+// CursorOf returns the position of the principal in a list ordered by the given sort order
+func CursorOf(p Principal, order PrincipalSortOrder) PrincipalCursor {
+	return PrincipalCursor{SortKey: p.SortKey(order), ID: p.ID}
+}
+
+// Before returns true if this position comes before the other one
+func (c PrincipalCursor) Before(other PrincipalCursor) bool {
+	if c.SortKey != other.SortKey {
+		return c.SortKey < other.SortKey
+	}
+	return c.ID < other.ID
+}
+
+// PrincipalQuery selects a page of principals
+type PrincipalQuery struct {
+	SortBy PrincipalSortOrder
+	Search string           // optional, only principals matching this term, see Principal.MatchesSearch
+	After  *PrincipalCursor // optional, only principals positioned after this one, i.e. the last one of the previous page
+	Limit  int              // optional, zero selects all principals
+}
+
+// Matches returns true if the principal matches the search term and comes after the cursor of the query
+func (q PrincipalQuery) Matches(p Principal) bool {
+	if q.After != nil && !q.After.Before(CursorOf(p, q.SortBy)) {
+		return false
+	}
+	return p.MatchesSearch(q.Search)
+}
+
+// Apply returns the matching principals in order, at most Limit of them. It reorders the given principals.
+func (q PrincipalQuery) Apply(principals []Principal) []Principal {
+	matching := principals[:0]
+	for _, p := range principals {
+		if q.Matches(p) {
+			matching = append(matching, p)
+		}
+	}
+
+	SortPrincipals(matching, q.SortBy)
+	if q.Limit > 0 && len(matching) > q.Limit {
+		matching = matching[:q.Limit]
+	}
+	return matching
+}
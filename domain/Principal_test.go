@@ -17,3 +17,49 @@ func TestPrincipalIsAnonymousFalseForSpecificPrincipal(t *testing.T) {
 
 	assert.False(t, p.IsAnonymous(), "Should NOT have been anonymous.")
 }
+
+func TestPrincipalMatchesSearchIgnoresCase(t *testing.T) {
+	p := NewPrincipal("alice", "Alice", "Smith", "asmith", "aspian")
+
+	assert.True(t, p.MatchesSearch("ali"))
+	assert.True(t, p.MatchesSearch("SMI"))
+	assert.True(t, p.MatchesSearch("asm"))
+	assert.True(t, p.MatchesSearch(""))
+	assert.False(t, p.MatchesSearch("bob"))
+}
+
+func TestSortPrincipals(t *testing.T) {
+	alice := NewPrincipal("3", "Alice", "Smith", "zed", "o1")
+	bob := NewPrincipal("1", "Bob", "Jones", "bob", "o1")
+	carol := NewPrincipal("2", "alice", "jones", "Carol", "o1")
+
+	principals := []Principal{alice, bob, carol}
+
+	SortPrincipals(principals, PrincipalSortByID)
+	assert.Equal(t, []Principal{bob, carol, alice}, principals)
+
+	SortPrincipals(principals, PrincipalSortByUsername)
+	assert.Equal(t, []Principal{bob, carol, alice}, principals)
+
+	SortPrincipals(principals, PrincipalSortByDisplayName)
+	assert.Equal(t, []Principal{carol, alice, bob}, principals)
+}
+
+func TestPrincipalQuerySelectsPageAfterCursor(t *testing.T) {
+	alice := NewPrincipal("3", "Alice", "Smith", "zed", "o1")
+	bob := NewPrincipal("1", "Bob", "Jones", "bob", "o1")
+	carol := NewPrincipal("2", "alice", "jones", "Carol", "o1")
+	dave := NewPrincipal("4", "Dave", "Jones", "dave", "o1")
+
+	q := PrincipalQuery{SortBy: PrincipalSortByDisplayName, Limit: 2}
+	first := q.Apply([]Principal{alice, bob, carol, dave})
+	assert.Equal(t, []Principal{carol, alice}, first)
+
+	after := CursorOf(first[1], q.SortBy)
+	q.After = &after
+	assert.Equal(t, []Principal{bob, dave}, q.Apply([]Principal{dave, carol, bob, alice}))
+
+	q.Search = "jones"
+	assert.Equal(t, []Principal{bob, dave}, q.Apply([]Principal{alice, bob, carol, dave}))
+	assert.False(t, q.Matches(carol), "Principals before the cursor should NOT match.")
+}
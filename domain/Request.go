@@ -4,4 +4,6 @@ package domain
 type Request struct {
 	//The principal sending the request
 	Requestor SubjectID
+	//True if the identity provider asserts that the requestor administrates the organization the request is about
+	RequestorIsOrgAdmin bool
 }
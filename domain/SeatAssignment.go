@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+// SeatAssignment is a seat in the license of an organization for a service that is held by a subject
+type SeatAssignment struct {
+	OrgID      string
+	ServiceID  string
+	SubjectID  SubjectID
+	AssignedAt time.Time // zero if the time of the assignment is unknown, e.g. for seats assigned before it was recorded
+}
@@ -0,0 +1,9 @@
+package domain
+
+// SeatReclamationEvent represents a request to enable or disable the automatic unassignment of seats held by disabled subjects for the license of an organization for a service
+type SeatReclamationEvent struct {
+	Request
+	Org     Organization
+	Service Service
+	Enabled bool
+}
@@ -0,0 +1,9 @@
+package domain
+
+// UpdateLicenseEvent represents a request to change the number of seats of an existing license
+type UpdateLicenseEvent struct {
+	OrgID           string
+	ServiceID       string
+	MaxSeats        int
+	DowngradePolicy DowngradePolicy
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"time"
+)
+
+// Webhook is an HTTPS endpoint subscribed to the license events of an organization
+type Webhook struct {
+	ID    string
+	OrgID string
+	// ServiceID restricts the subscription to the license of a single service, empty for the licenses of all services
+	ServiceID string
+	URL       string
+	// Secret is the key used to sign the payloads delivered to the webhook
+	Secret string
+}
+
+// NewWebhookID generates a new random webhook ID
+func NewWebhookID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// Matches returns true if the webhook is subscribed to the given event
+func (w Webhook) Matches(evt LicenseEvent) bool {
+	return w.OrgID == evt.OrgID && (w.ServiceID == "" || w.ServiceID == evt.ServiceID)
+}
+
+// reservedNetworks are IPv4 ranges not routed on the internet that the net package does not classify: "this network" and the carrier-grade NAT range
+var reservedNetworks = []*net.IPNet{
+	{IP: net.IPv4(0, 0, 0, 0), Mask: net.CIDRMask(8, 32)},
+	{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)},
+}
+
+// IsPublicWebhookAddress returns true if events may be delivered to the IP address. Loopback, private, link-local, unspecified and multicast addresses are refused, so that webhooks cannot reach services internal to the deployment.
+func IsPublicWebhookAddress(ip net.IP) bool {
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+
+	for _, network := range reservedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WebhookDelivery records an attempt to deliver a license event to a webhook
+type WebhookDelivery struct {
+	WebhookID string
+	Event     LicenseEvent
+	Attempt   int // starting at 1
+	Time      time.Time
+	// StatusCode is the HTTP status of the response, 0 if no response was received
+	StatusCode int
+	// Error describes why the attempt failed, empty if it succeeded
+	Error string
+}
+
+// Succeeded returns true if the webhook accepted the delivery
+func (d WebhookDelivery) Succeeded() bool {
+	return d.Error == ""
+}
+
+// PendingWebhookDelivery is a license event waiting to be delivered to a webhook
+type PendingWebhookDelivery struct {
+	// ID orders pending deliveries by the time their event was published
+	ID        string
+	WebhookID string
+	OrgID     string
+	Event     LicenseEvent
+	// Attempts is the number of failed attempts so far
+	Attempts int
+	// NextAttempt is when the delivery is due, the zero time for deliveries not attempted yet
+	NextAttempt time.Time
+}
+
+// NewWebhookDeliveryID generates a new ID of a recorded delivery attempt that sorts after the IDs generated before the given time
+func NewWebhookDeliveryID(t time.Time) string {
+	return newTimeOrderedID(t)
+}
+
+// NewPendingWebhookDeliveryID generates a new pending delivery ID that sorts after the IDs generated before the given time
+func NewPendingWebhookDeliveryID(t time.Time) string {
+	return newTimeOrderedID(t)
+}
+
+// Due returns true if the next attempt of the delivery is due at the given time
+func (d PendingWebhookDelivery) Due(now time.Time) bool {
+	return !d.NextAttempt.After(now)
+}
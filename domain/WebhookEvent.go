@@ -0,0 +1,7 @@
+package domain
+
+// WebhookEvent represents a request to register, list, remove or inspect the webhooks of an organization. Webhook.OrgID is always set, the other fields depend on the operation.
+type WebhookEvent struct {
+	Request
+	Webhook Webhook
+}
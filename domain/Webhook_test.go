@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhooksOnlyReachPublicAddresses(t *testing.T) {
+	for _, addr := range []string{"93.184.216.34", "2606:2800:220:1:248:1893:25c8:1946", "100.128.0.1"} {
+		assert.True(t, IsPublicWebhookAddress(net.ParseIP(addr)), addr)
+	}
+
+	for _, addr := range []string{
+		"127.0.0.1", "::1", "::ffff:127.0.0.1", // loopback
+		"10.1.2.3", "172.16.0.1", "192.168.1.1", "fd00::1", // private
+		"169.254.169.254", "fe80::1", // link-local, e.g. cloud metadata services
+		"0.0.0.0", "0.1.2.3", "::", // unspecified
+		"224.0.0.1", "ff02::1", // multicast
+		"100.64.0.1", // carrier-grade NAT
+	} {
+		assert.False(t, IsPublicWebhookAddress(net.ParseIP(addr)), addr)
+	}
+
+	assert.False(t, IsPublicWebhookAddress(nil))
+}
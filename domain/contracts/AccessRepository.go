@@ -4,9 +4,10 @@ package contracts
 
 import (
 	"authz/domain"
+	"context"
 )
 
 // AccessRepository - the contract for the access repository
 type AccessRepository interface {
-	CheckAccess(subjectID domain.SubjectID, operation string, resource domain.Resource) (domain.AccessDecision, error)
+	CheckAccess(ctx context.Context, subjectID domain.SubjectID, operation string, resource domain.Resource) (domain.AccessDecision, error)
 }
@@ -0,0 +1,14 @@
+package contracts
+
+import (
+	"authz/domain"
+	"context"
+)
+
+// AuditLog is a contract that describes the required operations for recording the license mutations of organizations
+type AuditLog interface {
+	// AddRecord appends a record to the log
+	AddRecord(ctx context.Context, record domain.AuditRecord) error
+	// GetRecords retrieves a page of the records selected by the query, oldest first. It fails with domain.ErrAuditLogNotQueryable if the log cannot read records back.
+	GetRecords(ctx context.Context, query domain.AuditQuery) (domain.AuditPage, error)
+}
@@ -0,0 +1,18 @@
+package contracts
+
+import (
+	"authz/domain"
+	"context"
+)
+
+// DeadLetterRepository is a contract that describes the required operations for storing subject events that could not be processed
+type DeadLetterRepository interface {
+	// AddDeadLetter stores a new dead letter
+	AddDeadLetter(ctx context.Context, letter domain.DeadLetter) error
+	// GetDeadLetters retrieves all dead letters, ordered by ID. It returns an empty slice if there are none.
+	GetDeadLetters(ctx context.Context) ([]domain.DeadLetter, error)
+	// GetDeadLetter retrieves a single dead letter. It fails with domain.ErrDeadLetterNotFound if there is no such dead letter.
+	GetDeadLetter(ctx context.Context, id string) (domain.DeadLetter, error)
+	// RemoveDeadLetter deletes a dead letter. It fails with domain.ErrDeadLetterNotFound if there is no such dead letter.
+	RemoveDeadLetter(ctx context.Context, id string) error
+}
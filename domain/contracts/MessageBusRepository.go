@@ -1,6 +1,11 @@
 package contracts
 
-// SubjectAddOrUpdateEvent represents a new or updated subject in the environment
+import (
+	"authz/domain"
+	"context"
+)
+
+// SubjectAddOrUpdateEvent represents a new, updated or deleted subject in the environment. A subject whose primary organization changed is updated with the new OrgID.
 type SubjectAddOrUpdateEvent struct {
 	// MsgRef represents any internal tracking information. This is meant to be used by the repository only. TODO: this wouldn't be necessary if SubjectAddOrUpdateEvent were an interface implemented by a repo-defined struct that could carry additional properties.
 	MsgRef interface{}
@@ -10,24 +15,51 @@ type SubjectAddOrUpdateEvent struct {
 	OrgID string
 	// Active indicates whether or not the subject's account is active
 	Active bool
+	// Deleted indicates that the subject was removed from the environment. OrgID may be empty then.
+	Deleted bool
+	// DeliveryAttempts is the number of times the message was delivered, including this delivery
+	DeliveryAttempts int
+	// Payload is the raw message content the event was parsed from
+	Payload string
+}
+
+// UnparseableMessage represents a message from the environment that could not be parsed into an event. The repository already rejected it.
+type UnparseableMessage struct {
+	// Payload is the raw message content
+	Payload string
+	// Err describes why the message could not be parsed
+	Err error
 }
 
 // UserEvents represents event inputs from the environment as a set of channels
 type UserEvents struct {
 	// SubjectChanges events represent a new or modified subject in the environment
 	SubjectChanges chan SubjectAddOrUpdateEvent
+	// Unparseable messages were received but could not be turned into events
+	Unparseable chan UnparseableMessage
 	// Errors events represent errors the repository was not able to automatically recover from after the initial connection was established
 	Errors chan error
 }
 
+// LicenseEventPublisher represents the abstract operation of notifying the environment about license changes
+type LicenseEventPublisher interface {
+	// PublishLicenseEvents sends the given events in order. It returns an error if any event could not be sent, events before it may have been sent.
+	PublishLicenseEvents(ctx context.Context, evts []domain.LicenseEvent) error
+}
+
 // MessageBusRepository represents the abstract operations for exchanging events in an enterprise environment
 type MessageBusRepository interface {
+	LicenseEventPublisher
 	// Connect establishes a connection to the environment and, if successful, returns an UserEvents struct. If not successful, an error is returned.
 	Connect() (UserEvents, error)
 	// Disconnect disconnects from the environment as gracefully as possible and frees all resources allocated by Connect
 	Disconnect()
-	// ReportSuccess sends confirmation to the broker that the message was processed successfully. This or ReportFailure MUST be called for any event received.
+	// IsConnected returns true while the connection to the environment is established, false before Connect succeeded, while reconnecting and after Disconnect
+	IsConnected() bool
+	// ReportSuccess sends confirmation to the broker that the message was processed successfully. This, ReportFailure or ReportDeadLettered MUST be called for any event received.
 	ReportSuccess(evt SubjectAddOrUpdateEvent) error
-	// ReportFailure informs the broker that the message was -not- processed successfully. This or ReportSuccess MUST be called for any event received.
+	// ReportFailure informs the broker that the message was -not- processed successfully and should be delivered again. This, ReportSuccess or ReportDeadLettered MUST be called for any event received.
 	ReportFailure(evt SubjectAddOrUpdateEvent) error
+	// ReportDeadLettered informs the broker that the message cannot be processed and must not be delivered again. This, ReportSuccess or ReportFailure MUST be called for any event received.
+	ReportDeadLettered(evt SubjectAddOrUpdateEvent, reason string) error
 }
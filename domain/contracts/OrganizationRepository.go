@@ -1,9 +1,16 @@
 package contracts
 
-import "authz/domain"
+import (
+	"authz/domain"
+	"context"
+)
 
 // OrganizationRepository is a contract that describes the required operations for accessing and manipulating organization and membership data
 type OrganizationRepository interface {
-	AddSubject(orgID string, subject domain.Subject) error
-	UpsertSubject(orgID string, subject domain.Subject) error
+	AddSubject(ctx context.Context, orgID string, subject domain.Subject) error
+	UpsertSubject(ctx context.Context, orgID string, subject domain.Subject) error
+	// RemoveSubject removes the membership of a subject in an organization, including its disabled and admin status. Removing a subject that is no member is a no-op. Seats are not released.
+	RemoveSubject(ctx context.Context, orgID string, subjectID domain.SubjectID) error
+	// GetSubjectOrgs retrieves the IDs of the organizations the subject is a member of, ordered by ID
+	GetSubjectOrgs(ctx context.Context, subjectID domain.SubjectID) ([]string, error)
 }
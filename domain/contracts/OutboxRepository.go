@@ -0,0 +1,14 @@
+package contracts
+
+import (
+	"authz/domain"
+	"context"
+)
+
+// OutboxRepository provides the license events a store recorded atomically with the changes they notify about, until they are marked as sent
+type OutboxRepository interface {
+	// GetPendingEvents retrieves up to limit events that were not marked as sent yet, oldest first
+	GetPendingEvents(ctx context.Context, limit int) ([]domain.OutboxEvent, error)
+	// MarkEventsSent removes the events with the given IDs from the outbox. Unknown IDs are ignored.
+	MarkEventsSent(ctx context.Context, ids []string) error
+}
@@ -2,12 +2,15 @@ package contracts
 
 import (
 	"authz/domain"
+	"context"
 )
 
 // PrincipalRepository is a contract that describes the required operations for accessing principal data
 type PrincipalRepository interface {
 	// GetByID retrieves a principal for the given ID. If no ID is provided (ex: empty string), it returns an anonymous principal. If any error occurs, it's returned.
-	GetByID(id domain.SubjectID) (domain.Principal, error)
+	GetByID(ctx context.Context, id domain.SubjectID) (domain.Principal, error)
 	// GetByIDs is a bulk version of GetByID to allow the underlying implementation to optimize access to sets of principals and should otherwise have the same behavior.
-	GetByIDs(ids []domain.SubjectID) ([]domain.Principal, error)
+	GetByIDs(ctx context.Context, ids []domain.SubjectID) ([]domain.Principal, error)
+	// GetPageByIDs retrieves the principals with the given IDs that match the query, in the order of the query and at most as many as its limit. Implementations should not hold all principals in memory at once.
+	GetPageByIDs(ctx context.Context, ids []domain.SubjectID, query domain.PrincipalQuery) ([]domain.Principal, error)
 }
@@ -2,20 +2,39 @@ package contracts
 
 import (
 	"authz/domain"
+	"context"
 )
 
 // SeatLicenseRepository is a contract that describes the required operations for accessing and manipulating per-seat license data
 type SeatLicenseRepository interface {
 	// ModifySeats atomically persists changes to seat assignments for a license
-	ModifySeats(assignedSubjectIDs []domain.SubjectID, removedSubjectIDs []domain.SubjectID, license *domain.License, orgID string, svc domain.Service) error
+	ModifySeats(ctx context.Context, assignedSubjectIDs []domain.SubjectID, removedSubjectIDs []domain.SubjectID, license *domain.License, orgID string, svc domain.Service) error
 	// GetLicense retrieves the stored license for the given organization and service, if any.
-	GetLicense(orgID string, serviceID string) (*domain.License, error)
+	GetLicense(ctx context.Context, orgID string, serviceID string) (*domain.License, error)
+	// GetLicenses retrieves all licenses applied to the given organization, ordered by service ID. It returns an empty slice for orgs without licenses or unknown orgs
+	GetLicenses(ctx context.Context, orgID string) ([]*domain.License, error)
 	// HasAnyLicense returns true if an org has at least one license applied, false otherwise for orgs without licenses or unknown orgs
-	HasAnyLicense(orgID string) (bool, error)
-	// GetAssignable retrieves the IDs of the subjects who are assignable, but not already assigned, to seats in the current license
-	GetAssignable(orgID string, serviceID string) ([]domain.SubjectID, error)
-	// GetAssigned retrieves the IDs of the subjects assigned seats in the current license
-	GetAssigned(orgID string, serviceID string) ([]domain.SubjectID, error)
+	HasAnyLicense(ctx context.Context, orgID string) (bool, error)
+	// GetAssignable retrieves the IDs of the subjects who are assignable, but not already assigned, to seats in the current license, ordered by ID
+	GetAssignable(ctx context.Context, orgID string, serviceID string) ([]domain.SubjectID, error)
+	// GetAssigned retrieves the IDs of the subjects assigned seats in the current license, ordered by ID
+	GetAssigned(ctx context.Context, orgID string, serviceID string) ([]domain.SubjectID, error)
+	// GetReclaimable retrieves the IDs of the disabled subjects who are still assigned seats in the current license, ordered by ID
+	GetReclaimable(ctx context.Context, orgID string, serviceID string) ([]domain.SubjectID, error)
+	// GetSeatAssignments retrieves the seats the given subject holds in licenses of the given organization, ordered by service ID
+	GetSeatAssignments(ctx context.Context, orgID string, subjectID domain.SubjectID) ([]domain.SeatAssignment, error)
 	// ApplyLicense stores the given license associated with its service and organization
-	ApplyLicense(license *domain.License) error
+	ApplyLicense(ctx context.Context, license *domain.License) error
+	// UpdateLicense atomically replaces the seat limit and version of a stored license. It fails with domain.ErrConflict if the license changed since current was read.
+	UpdateLicense(ctx context.Context, current *domain.License, updated *domain.License) error
+	// RevokeLicense removes the license of an organization for a service together with all its seat assignments and license admins. The license is removed atomically, its seat assignments may be removed afterwards. It fails with domain.ErrLicenseNotFound if there is no such license.
+	RevokeLicense(ctx context.Context, orgID string, serviceID string) error
+	// SetSeatReclamation enables or disables the automatic unassignment of seats held by disabled subjects for the license of an organization for a service. It fails with domain.ErrLicenseNotFound if there is no such license.
+	SetSeatReclamation(ctx context.Context, orgID string, serviceID string, enabled bool) error
+	// GetLicensesReclaimingSeats retrieves the licenses of all organizations that have seat reclamation enabled, ordered by organization and service ID
+	GetLicensesReclaimingSeats(ctx context.Context) ([]*domain.License, error)
+	// AddLicenseAdmin allows the given subject to manage the license of an organization for a service. Adding an existing license admin again is a no-op. It fails with domain.ErrLicenseNotFound if there is no such license.
+	AddLicenseAdmin(ctx context.Context, orgID string, serviceID string, subjectID domain.SubjectID) error
+	// RemoveLicenseAdmin revokes the permission of the given subject to manage the license of an organization for a service. Removing a subject that is no license admin is a no-op.
+	RemoveLicenseAdmin(ctx context.Context, orgID string, serviceID string, subjectID domain.SubjectID) error
 }
@@ -1,9 +1,12 @@
 package contracts
 
-import "authz/domain"
+import (
+	"authz/domain"
+	"context"
+)
 
 // SubjectRepository represents functionality required to get access-relevant data about subjects
 type SubjectRepository interface {
 	// GetByOrgID retrieves all members of the given organization
-	GetByOrgID(orgID string) (chan domain.Subject, chan error)
+	GetByOrgID(ctx context.Context, orgID string) (chan domain.Subject, chan error)
 }
@@ -0,0 +1,43 @@
+package contracts
+
+import (
+	"authz/domain"
+	"context"
+	"time"
+)
+
+// WebhookRepository is a contract that describes the required operations for storing the webhooks organizations registered for license events
+type WebhookRepository interface {
+	// AddWebhook stores a new webhook
+	AddWebhook(ctx context.Context, webhook domain.Webhook) error
+	// GetWebhooks retrieves the webhooks of an organization, ordered by ID. It returns an empty slice for orgs without webhooks.
+	GetWebhooks(ctx context.Context, orgID string) ([]domain.Webhook, error)
+	// RemoveWebhook deletes a webhook of an organization. It fails with domain.ErrWebhookNotFound if the org has no such webhook.
+	RemoveWebhook(ctx context.Context, orgID string, webhookID string) error
+}
+
+// WebhookDeliveryLog records the attempts to deliver license events to webhooks
+type WebhookDeliveryLog interface {
+	// AddDelivery records an attempt to deliver an event. Logs may discard old records.
+	AddDelivery(ctx context.Context, delivery domain.WebhookDelivery) error
+	// GetDeliveries retrieves the recorded attempts to deliver events to a webhook, most recent first
+	GetDeliveries(ctx context.Context, webhookID string) ([]domain.WebhookDelivery, error)
+}
+
+// WebhookDeliveryQueue keeps the deliveries of license events to webhooks until they succeed or are given up
+type WebhookDeliveryQueue interface {
+	// AddPendingDeliveries stores new pending deliveries
+	AddPendingDeliveries(ctx context.Context, deliveries []domain.PendingWebhookDelivery) error
+	// GetDueDeliveries retrieves up to limit pending deliveries that are due at the given time, ordered by ID
+	GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.PendingWebhookDelivery, error)
+	// UpdatePendingDelivery replaces a pending delivery after a failed attempt
+	UpdatePendingDelivery(ctx context.Context, delivery domain.PendingWebhookDelivery) error
+	// RemovePendingDelivery deletes a pending delivery that succeeded or was given up. Unknown IDs are ignored.
+	RemovePendingDelivery(ctx context.Context, id string) error
+}
+
+// WebhookSender delivers license events to webhooks
+type WebhookSender interface {
+	// Send delivers a single event to the webhook, unless ctx is canceled before. It returns the HTTP status of the response, or 0 if none was received, and an error unless the webhook accepted the event.
+	Send(ctx context.Context, webhook domain.Webhook, evt domain.LicenseEvent) (int, error)
+}
@@ -4,6 +4,8 @@ package services
 import (
 	"authz/domain"
 	"authz/domain/contracts"
+	"context"
+	"strings"
 )
 
 // AccessService is a domain service for abstract access management (ex: querying whether access has been granted.)
@@ -17,19 +19,18 @@ func NewAccessService(accessRepository contracts.AccessRepository) AccessService
 }
 
 // Check processes a CheckEvent and returns true or false if successful, otherwise error
-func (a AccessService) Check(req domain.CheckEvent) (domain.AccessDecision, error) {
+func (a AccessService) Check(ctx context.Context, req domain.CheckEvent) (domain.AccessDecision, error) {
 	if !req.Requestor.HasIdentity() {
 		return false, domain.ErrNotAuthenticated
 	}
-	//TODO: implement actual meta-authz
-	accessResult, err := true, error(nil) //a.accessRepository.CheckAccess(req.Requestor, "call", model.Resource{Type: "endpoint", ID: "checkaccess"})
-	if err != nil {
-		return false, err
-	}
 
-	if !accessResult {
-		return false, domain.ErrNotAuthorized
+	if req.Resource.Type == "license" {
+		// Checks of licenses reveal their seat assignments, so only those managing the license may run them. License IDs are in the format {org ID}/{service ID}
+		orgID, serviceID, _ := strings.Cut(req.Resource.ID, "/")
+		if err := ensureRequestorCanManageLicenses(ctx, a.accessRepository, req.Requestor, req.RequestorIsOrgAdmin, domain.Organization{ID: orgID}, domain.Service{ID: serviceID}); err != nil {
+			return false, err
+		}
 	}
 
-	return a.accessRepository.CheckAccess(req.SubjectID, req.Operation, req.Resource)
+	return a.accessRepository.CheckAccess(ctx, req.SubjectID, req.Operation, req.Resource)
 }
@@ -3,13 +3,13 @@ package services
 import (
 	"authz/domain"
 	"authz/domain/contracts"
+	"context"
 	"testing"
 )
 
 func TestCheckErrorsWhenCallerNotAuthorized(t *testing.T) {
-	t.SkipNow() //Skip until meta-authz is in place
 	access := NewAccessService(spicedbSeatLicenseRepository())
-	_, err := access.Check(objFromRequest(
+	_, err := access.Check(context.Background(), objFromRequest(
 		"other system",
 		"okay",
 		"check",
@@ -21,9 +21,27 @@ func TestCheckErrorsWhenCallerNotAuthorized(t *testing.T) {
 	}
 }
 
+func TestCheckOfOtherResourcesDoesNotRequireLicenseManagement(t *testing.T) {
+	access := NewAccessService(spicedbSeatLicenseRepository())
+	result, err := access.Check(context.Background(), objFromRequest(
+		"other system",
+		"u4",
+		"disabled",
+		"org",
+		"o1"))
+
+	if err != nil {
+		t.Errorf("Expected a result, got error: %s", err)
+	}
+
+	if result != true {
+		t.Errorf("Expected success, got fail.")
+	}
+}
+
 func TestCheckReturnsTrueWhenStoreReturnsTrue(t *testing.T) {
 	access := NewAccessService(spicedbSeatLicenseRepository())
-	result, err := access.Check(objFromRequest(
+	result, err := access.Check(context.Background(), objFromRequest(
 		"system",
 		"u1",
 		"access",
@@ -41,7 +59,7 @@ func TestCheckReturnsTrueWhenStoreReturnsTrue(t *testing.T) {
 
 func TestCheckReturnsFalseWhenStoreReturnsFalse(t *testing.T) {
 	access := NewAccessService(spicedbSeatLicenseRepository())
-	result, err := access.Check(objFromRequest(
+	result, err := access.Check(context.Background(), objFromRequest(
 		"system",
 		"bad",
 		"access",
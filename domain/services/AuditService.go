@@ -0,0 +1,28 @@
+package services
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"context"
+)
+
+// AuditService provides the audit records of organizations. Only subjects who may manage all licenses of an organization may read all its records, license admins may read the records about their license.
+type AuditService struct {
+	log   contracts.AuditLog
+	authz contracts.AccessRepository
+}
+
+// NewAuditService constructs a new AuditService
+func NewAuditService(log contracts.AuditLog, authz contracts.AccessRepository) *AuditService {
+	return &AuditService{log: log, authz: authz}
+}
+
+// GetRecords gets a page of the audit records selected by the query of the event, oldest first
+func (a *AuditService) GetRecords(ctx context.Context, evt domain.AuditEvent) (domain.AuditPage, error) {
+	err := ensureRequestorCanManageLicenses(ctx, a.authz, evt.Requestor, evt.RequestorIsOrgAdmin, domain.Organization{ID: evt.Query.OrgID}, domain.Service{ID: evt.Query.ServiceID})
+	if err != nil {
+		return domain.AuditPage{}, err
+	}
+
+	return a.log.GetRecords(ctx, evt.Query)
+}
@@ -0,0 +1,22 @@
+package services
+
+import (
+	"authz/domain"
+	"errors"
+)
+
+// conflictAttempts is how often an operation is attempted when the data it modifies is modified concurrently
+const conflictAttempts = 3
+
+// retryOnConflict runs op until it does not fail with domain.ErrConflict, but at most conflictAttempts times. op has to read the data it modifies again on each attempt.
+func retryOnConflict(op func() error) error {
+	var err error
+	for attempt := 1; attempt <= conflictAttempts; attempt++ {
+		err = op()
+		if !errors.Is(err, domain.ErrConflict) {
+			return err
+		}
+	}
+
+	return err
+}
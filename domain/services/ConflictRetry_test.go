@@ -0,0 +1,46 @@
+package services
+
+import (
+	"authz/domain"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryOnConflictStopsAfterSuccess(t *testing.T) {
+	attempts := 0
+	err := retryOnConflict(func() error {
+		attempts++
+		if attempts < conflictAttempts {
+			return domain.ErrConflict
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, conflictAttempts, attempts)
+}
+
+func TestRetryOnConflictIsBounded(t *testing.T) {
+	attempts := 0
+	err := retryOnConflict(func() error {
+		attempts++
+		return domain.ErrConflict
+	})
+
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	assert.Equal(t, conflictAttempts, attempts)
+}
+
+func TestRetryOnConflictDoesNotRetryOtherErrors(t *testing.T) {
+	failure := errors.New("unavailable")
+	attempts := 0
+	err := retryOnConflict(func() error {
+		attempts++
+		return failure
+	})
+
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, 1, attempts)
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"context"
+)
+
+// manageLicensePermission is granted on organizations and services to subjects who may manage their licenses
+const manageLicensePermission = "manage_license"
+
+// ensureRequestorCanManageLicenses succeeds if the requestor is an admin of the organization according to the identity provider, or has been granted the permission to manage licenses on the organization, the service or the license of the organization for the service in the store. An empty service ID only considers the organization.
+func ensureRequestorCanManageLicenses(ctx context.Context, authz contracts.AccessRepository, requestor domain.SubjectID, requestorIsOrgAdmin bool, org domain.Organization, svc domain.Service) error {
+	if !requestor.HasIdentity() {
+		return domain.ErrNotAuthenticated
+	}
+
+	if requestorIsOrgAdmin {
+		return nil
+	}
+
+	resources := []domain.Resource{org.AsResource()}
+	if svc.ID != "" {
+		license := domain.License{OrgID: org.ID, ServiceID: svc.ID}
+		resources = append(resources, svc.AsResource(), license.AsResource())
+	}
+
+	for _, resource := range resources {
+		authorized, err := authz.CheckAccess(ctx, requestor, manageLicensePermission, resource)
+		if err != nil {
+			return err
+		}
+
+		if authorized {
+			return nil
+		}
+	}
+
+	return domain.ErrNotAuthorized
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"context"
+)
+
+// MembershipService removes subjects from organizations on behalf of the system, e.g. when users are deleted or move to another organization
+type MembershipService struct {
+	seats contracts.SeatLicenseRepository
+	orgs  contracts.OrganizationRepository
+}
+
+// NewMembershipService constructs a new MembershipService
+func NewMembershipService(seats contracts.SeatLicenseRepository, orgs contracts.OrganizationRepository) *MembershipService {
+	return &MembershipService{seats: seats, orgs: orgs}
+}
+
+// RemoveSubject removes a subject from an organization, releasing its seats and revoking its license admin permissions. It can be retried after a failure.
+func (m *MembershipService) RemoveSubject(ctx context.Context, orgID string, subjectID domain.SubjectID) error {
+	// Seats are released while the subject is still a member, so that a retry finds the org again, and once more afterwards for seats assigned in between
+	if err := m.releaseSeats(ctx, orgID, subjectID); err != nil {
+		return err
+	}
+
+	licenses, err := m.seats.GetLicenses(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	for _, license := range licenses {
+		if err := m.seats.RemoveLicenseAdmin(ctx, orgID, license.ServiceID, subjectID); err != nil {
+			return err
+		}
+	}
+
+	if err := m.orgs.RemoveSubject(ctx, orgID, subjectID); err != nil {
+		return err
+	}
+
+	return m.releaseSeats(ctx, orgID, subjectID)
+}
+
+// releaseSeats unassigns all seats the subject holds in licenses of the organization, adjusting the seat count of each license. It is retried if seats are modified concurrently, only the seats still held are released then.
+func (m *MembershipService) releaseSeats(ctx context.Context, orgID string, subjectID domain.SubjectID) error {
+	return retryOnConflict(func() error {
+		return m.releaseHeldSeats(ctx, orgID, subjectID)
+	})
+}
+
+func (m *MembershipService) releaseHeldSeats(ctx context.Context, orgID string, subjectID domain.SubjectID) error {
+	assignments, err := m.seats.GetSeatAssignments(ctx, orgID, subjectID)
+	if err != nil {
+		return err
+	}
+
+	for _, assignment := range assignments {
+		license, err := m.seats.GetLicense(ctx, orgID, assignment.ServiceID)
+		if err != nil {
+			return err
+		}
+
+		if err := m.seats.ModifySeats(ctx, nil, []domain.SubjectID{subjectID}, license, orgID, domain.Service{ID: assignment.ServiceID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
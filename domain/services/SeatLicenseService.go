@@ -3,6 +3,7 @@ package services
 import (
 	"authz/domain"
 	"authz/domain/contracts"
+	"context"
 )
 
 // SeatLicenseService performs operations related to per-seat licensing
@@ -12,12 +13,12 @@ type SeatLicenseService struct {
 }
 
 // ModifySeats handles ModifySeatAssignmentEvents to assign and unassign seats
-func (l *SeatLicenseService) ModifySeats(evt domain.ModifySeatAssignmentEvent) error {
-	if err := l.ensureRequestorIsAuthorizedToManageLicenses(evt.Requestor); err != nil {
+func (l *SeatLicenseService) ModifySeats(ctx context.Context, evt domain.ModifySeatAssignmentEvent) error {
+	if err := ensureRequestorCanManageLicenses(ctx, l.authz, evt.Requestor, evt.RequestorIsOrgAdmin, evt.Org, evt.Service); err != nil {
 		return err
 	}
 
-	license, err := l.seats.GetLicense(evt.Org.ID, evt.Service.ID)
+	license, err := l.seats.GetLicense(ctx, evt.Org.ID, evt.Service.ID)
 	if err != nil {
 		return err
 	}
@@ -26,55 +27,132 @@ func (l *SeatLicenseService) ModifySeats(evt domain.ModifySeatAssignmentEvent) e
 		return domain.ErrLicenseLimitExceeded
 	}
 
-	return l.seats.ModifySeats(evt.Assign, evt.UnAssign, license, evt.Org.ID, evt.Service)
+	return l.seats.ModifySeats(ctx, evt.Assign, evt.UnAssign, license, evt.Org.ID, evt.Service)
 }
 
 // GetLicense gets the License for the provided information
-func (l *SeatLicenseService) GetLicense(evt domain.GetLicenseEvent) (*domain.License, error) {
-	if err := l.ensureRequestorIsAuthorizedToManageLicenses(evt.Requestor); err != nil {
+func (l *SeatLicenseService) GetLicense(ctx context.Context, evt domain.GetLicenseEvent) (*domain.License, error) {
+	if err := l.ensureRequestorCanManageLicense(ctx, evt); err != nil {
 		return nil, err
 	}
 
-	return l.seats.GetLicense(evt.OrgID, evt.ServiceID)
+	return l.seats.GetLicense(ctx, evt.OrgID, evt.ServiceID)
 }
 
-// GetAssignableSeats get the subject that can be assigned to a given license
-func (l *SeatLicenseService) GetAssignableSeats(evt domain.GetLicenseEvent) ([]domain.SubjectID, error) {
-	if err := l.ensureRequestorIsAuthorizedToManageLicenses(evt.Requestor); err != nil {
+// GetLicenses gets all licenses of the provided organization
+func (l *SeatLicenseService) GetLicenses(ctx context.Context, evt domain.ListLicensesEvent) ([]*domain.License, error) {
+	if err := ensureRequestorCanManageLicenses(ctx, l.authz, evt.Requestor, evt.RequestorIsOrgAdmin, domain.Organization{ID: evt.OrgID}, domain.Service{}); err != nil {
 		return nil, err
 	}
 
-	return l.seats.GetAssignable(evt.OrgID, evt.ServiceID)
+	return l.seats.GetLicenses(ctx, evt.OrgID)
 }
 
-// GetAssignedSeats gets the subjects assigned to the given license
-func (l *SeatLicenseService) GetAssignedSeats(evt domain.GetLicenseEvent) ([]domain.SubjectID, error) {
-	if err := l.ensureRequestorIsAuthorizedToManageLicenses(evt.Requestor); err != nil {
-		return nil, err
+// GetSeatAssignments gets the seats the provided subject holds in the licenses of an organization
+func (l *SeatLicenseService) GetSeatAssignments(ctx context.Context, evt domain.GetUserLicensesEvent) ([]domain.SeatAssignment, error) {
+	if !evt.Requestor.HasIdentity() {
+		return nil, domain.ErrNotAuthenticated
 	}
 
-	return l.seats.GetAssigned(evt.OrgID, evt.ServiceID)
+	// Users may always look up their own seats
+	if evt.Requestor != evt.SubjectID {
+		if err := ensureRequestorCanManageLicenses(ctx, l.authz, evt.Requestor, evt.RequestorIsOrgAdmin, domain.Organization{ID: evt.OrgID}, domain.Service{}); err != nil {
+			return nil, err
+		}
+	}
+
+	return l.seats.GetSeatAssignments(ctx, evt.OrgID, evt.SubjectID)
 }
 
-// NewSeatLicenseService constructs a new SeatLicenseService
-func NewSeatLicenseService(seats contracts.SeatLicenseRepository, authz contracts.AccessRepository) *SeatLicenseService {
-	return &SeatLicenseService{seats: seats, authz: authz}
+// UpdateLicense changes the number of seats of an existing license, applying the given downgrade policy if fewer seats than in use remain
+func (l *SeatLicenseService) UpdateLicense(ctx context.Context, evt domain.UpdateLicenseEvent) (*domain.License, error) {
+	license, err := l.seats.GetLicense(ctx, evt.OrgID, evt.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !license.Exists() {
+		return nil, domain.ErrLicenseNotFound
+	}
+
+	if license.MaxSeats == evt.MaxSeats {
+		return license, nil
+	}
+
+	if evt.MaxSeats < license.InUse && evt.DowngradePolicy != domain.DowngradePolicyReport {
+		return nil, domain.ErrLicenseLimitExceeded
+	}
+
+	updated := *license
+	updated.MaxSeats = evt.MaxSeats
+	updated.Version = domain.NewLicenseVersion()
+
+	if err := l.seats.UpdateLicense(ctx, license, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
 }
 
-func (l *SeatLicenseService) ensureRequestorIsAuthorizedToManageLicenses(requestor domain.SubjectID) error {
-	if !requestor.HasIdentity() {
-		return domain.ErrNotAuthenticated
+// GrantLicenseAdmin delegates the management of the license of an organization for a service to a subject. Only org admins may delegate.
+func (l *SeatLicenseService) GrantLicenseAdmin(ctx context.Context, evt domain.LicenseAdminEvent) error {
+	if err := ensureRequestorCanManageLicenses(ctx, l.authz, evt.Requestor, evt.RequestorIsOrgAdmin, evt.Org, domain.Service{}); err != nil {
+		return err
 	}
 
-	//TODO: implement meta-authz
-	authz, err := true, error(nil) //l.authz.CheckAccess(requestor, "manage_license", org.AsResource()) //Maybe on a per-service basis?
+	license, err := l.seats.GetLicense(ctx, evt.Org.ID, evt.Service.ID)
 	if err != nil {
 		return err
 	}
 
-	if !authz {
-		return domain.ErrNotAuthorized
+	if !license.Exists() {
+		return domain.ErrLicenseNotFound
+	}
+
+	return l.seats.AddLicenseAdmin(ctx, evt.Org.ID, evt.Service.ID, evt.SubjectID)
+}
+
+// RevokeLicenseAdmin withdraws the delegated management of the license of an organization for a service from a subject. Only org admins may revoke.
+func (l *SeatLicenseService) RevokeLicenseAdmin(ctx context.Context, evt domain.LicenseAdminEvent) error {
+	if err := ensureRequestorCanManageLicenses(ctx, l.authz, evt.Requestor, evt.RequestorIsOrgAdmin, evt.Org, domain.Service{}); err != nil {
+		return err
+	}
+
+	return l.seats.RemoveLicenseAdmin(ctx, evt.Org.ID, evt.Service.ID, evt.SubjectID)
+}
+
+// SetSeatReclamation enables or disables the automatic unassignment of seats held by disabled subjects for the license of an organization for a service
+func (l *SeatLicenseService) SetSeatReclamation(ctx context.Context, evt domain.SeatReclamationEvent) error {
+	if err := ensureRequestorCanManageLicenses(ctx, l.authz, evt.Requestor, evt.RequestorIsOrgAdmin, evt.Org, evt.Service); err != nil {
+		return err
+	}
+
+	return l.seats.SetSeatReclamation(ctx, evt.Org.ID, evt.Service.ID, evt.Enabled)
+}
+
+// GetAssignableSeats get the subject that can be assigned to a given license
+func (l *SeatLicenseService) GetAssignableSeats(ctx context.Context, evt domain.GetLicenseEvent) ([]domain.SubjectID, error) {
+	if err := l.ensureRequestorCanManageLicense(ctx, evt); err != nil {
+		return nil, err
+	}
+
+	return l.seats.GetAssignable(ctx, evt.OrgID, evt.ServiceID)
+}
+
+// GetAssignedSeats gets the subjects assigned to the given license
+func (l *SeatLicenseService) GetAssignedSeats(ctx context.Context, evt domain.GetLicenseEvent) ([]domain.SubjectID, error) {
+	if err := l.ensureRequestorCanManageLicense(ctx, evt); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return l.seats.GetAssigned(ctx, evt.OrgID, evt.ServiceID)
+}
+
+// NewSeatLicenseService constructs a new SeatLicenseService
+func NewSeatLicenseService(seats contracts.SeatLicenseRepository, authz contracts.AccessRepository) *SeatLicenseService {
+	return &SeatLicenseService{seats: seats, authz: authz}
+}
+
+func (l *SeatLicenseService) ensureRequestorCanManageLicense(ctx context.Context, evt domain.GetLicenseEvent) error {
+	return ensureRequestorCanManageLicenses(ctx, l.authz, evt.Requestor, evt.RequestorIsOrgAdmin, domain.Organization{ID: evt.OrgID}, domain.Service{ID: evt.ServiceID})
 }
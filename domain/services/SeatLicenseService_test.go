@@ -3,6 +3,7 @@ package services
 import (
 	"authz/domain"
 	"authz/domain/contracts"
+	"context"
 	"errors"
 	"strconv"
 	"sync"
@@ -21,7 +22,7 @@ func TestLicensingModifySeatsErrorsWhenNotAuthenticated(t *testing.T) {
 	store := spicedbSeatLicenseRepository()
 	lic := NewSeatLicenseService(store.(contracts.SeatLicenseRepository), store)
 
-	err := lic.ModifySeats(req)
+	err := lic.ModifySeats(context.Background(), req)
 
 	assert.ErrorIs(t, err, domain.ErrNotAuthenticated)
 }
@@ -33,7 +34,7 @@ func TestNewAssignedUserNotAssignableButNewUnassignedUserIs(t *testing.T) {
 
 	// when
 	req := modifyLicRequestFromVars("okay", "o1", []string{"u2"}, []string{"u1"})
-	err := lic.ModifySeats(req)
+	err := lic.ModifySeats(context.Background(), req)
 	assert.NoError(t, err)
 
 	// then
@@ -42,12 +43,12 @@ func TestNewAssignedUserNotAssignableButNewUnassignedUserIs(t *testing.T) {
 		OrgID:     "o1",
 		ServiceID: "smarts",
 	}
-	assignable, err := lic.GetAssignableSeats(getevt)
+	assignable, err := lic.GetAssignableSeats(context.Background(), getevt)
 	assert.NoError(t, err)
 	expectedAssignableUsers := []domain.SubjectID{"u1", "u5", "u6", "u7", "u8", "u9", "u10", "u11", "u12", "u13", "u14", "u15", "u16", "u17", "u18", "u19", "u20"}
 	assert.ElementsMatch(t, expectedAssignableUsers, assignable)
 
-	assigned, err := lic.GetAssignedSeats(getevt)
+	assigned, err := lic.GetAssignedSeats(context.Background(), getevt)
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, []domain.SubjectID{"u2", "u3"}, assigned)
 }
@@ -59,7 +60,7 @@ func TestDisabledUserNotAssignable(t *testing.T) {
 
 	// when
 	req := modifyLicRequestFromVars("okay", "o1", []string{"u3"}, nil)
-	err := lic.ModifySeats(req)
+	err := lic.ModifySeats(context.Background(), req)
 
 	// then
 	assert.Error(t, err)
@@ -72,7 +73,7 @@ func TestNonExistentUserNotAssignable(t *testing.T) {
 
 	// when
 	req := modifyLicRequestFromVars("okay", "o1", []string{"u777"}, nil)
-	err := lic.ModifySeats(req)
+	err := lic.ModifySeats(context.Background(), req)
 
 	// then
 	assert.Error(t, err)
@@ -85,7 +86,7 @@ func TestDuplicateUserAssignmentNotAllowed(t *testing.T) {
 
 	//when
 	req := modifyLicRequestFromVars("okay", "o1", []string{"u1"}, nil)
-	err := lic.ModifySeats(req)
+	err := lic.ModifySeats(context.Background(), req)
 
 	//then
 	assert.Error(t, err)
@@ -100,11 +101,11 @@ func TestSeatLicenseOverAssignment(t *testing.T) {
 
 	//when
 	req := modifyLicRequestFromVars("okay", "o1", []string{"u5"}, []string{})
-	err = lic.ModifySeats(req)
+	err = lic.ModifySeats(context.Background(), req)
 
 	//then
 	assert.ErrorIs(t, err, domain.ErrLicenseLimitExceeded)
-	license, err := lic.GetLicense(domain.GetLicenseEvent{
+	license, err := lic.GetLicense(context.Background(), domain.GetLicenseEvent{
 		Requestor: "okay",
 		OrgID:     "o1",
 		ServiceID: "smarts",
@@ -127,7 +128,7 @@ func TestConcurrentSwapsCannotReplaceTheSameUser(t *testing.T) {
 	for i := 0; i < runCount; i++ {
 		go func(run int) {
 			req := modifyLicRequestFromVars("okay", "o1", []string{"user-" + strconv.Itoa(run)}, []string{"u1"})
-			errs <- lic.ModifySeats(req)
+			errs <- lic.ModifySeats(context.Background(), req)
 			wait.Done()
 		}(i)
 	}
@@ -154,13 +155,13 @@ func TestDisabledAssignedUsersCanBeUnassigned(t *testing.T) {
 	req := modifyLicRequestFromVars("okay", "o1", []string{}, []string{"u3"}) //u3 is assigned and disabled
 
 	//when
-	err := lic.ModifySeats(req)
+	err := lic.ModifySeats(context.Background(), req)
 
 	//then
 	assert.NoError(t, err)
 	spicedbContainer.WaitForQuantizationInterval()
 
-	assigned, err := lic.GetAssignedSeats(domain.GetLicenseEvent{
+	assigned, err := lic.GetAssignedSeats(context.Background(), domain.GetLicenseEvent{
 		Requestor: "okay",
 		OrgID:     "o1",
 		ServiceID: "smarts",
@@ -182,7 +183,7 @@ func TestConcurrentIndividualRequestsCannotExceedLimit(t *testing.T) {
 	for i := 0; i < runCount; i++ {
 		go func(run int) {
 			req := modifyLicRequestFromVars("okay", "o1", []string{"user-" + strconv.Itoa(run)}, []string{})
-			errs <- lic.ModifySeats(req)
+			errs <- lic.ModifySeats(context.Background(), req)
 			wait.Done()
 		}(i)
 	}
@@ -220,7 +221,7 @@ func TestConcurrentRequestsCannotExceedLimit(t *testing.T) {
 			}
 
 			req := modifyLicRequestFromVars("okay", "o1", subjects, []string{})
-			errs <- lic.ModifySeats(req)
+			errs <- lic.ModifySeats(context.Background(), req)
 			wait.Done()
 		}(i)
 	}
@@ -246,10 +247,10 @@ func assertLicenseCountIsCorrect(t *testing.T, lic *SeatLicenseService) {
 		OrgID:     "o1",
 		ServiceID: "smarts",
 	}
-	license, err := lic.GetLicense(getevt)
+	license, err := lic.GetLicense(context.Background(), getevt)
 	assert.NoError(t, err)
 
-	seats, err := lic.GetAssignedSeats(getevt)
+	seats, err := lic.GetAssignedSeats(context.Background(), getevt)
 	assert.NoError(t, err)
 	assert.GreaterOrEqual(t, license.InUse, 0)
 	assert.LessOrEqual(t, license.InUse, license.MaxSeats)
@@ -266,17 +267,17 @@ func TestModifySeatsRequestWithNoSubjectsIsNoOp(t *testing.T) {
 		OrgID:     "o1",
 		ServiceID: "smarts",
 	}
-	licBefore, err := service.GetLicense(getevt)
+	licBefore, err := service.GetLicense(context.Background(), getevt)
 	assert.NoError(t, err)
-	seatsBefore, err := service.GetAssignedSeats(getevt)
+	seatsBefore, err := service.GetAssignedSeats(context.Background(), getevt)
 	assert.NoError(t, err)
 
-	err = service.ModifySeats(modifyLicRequestFromVars("system", "o1", []string{}, []string{}))
+	err = service.ModifySeats(context.Background(), modifyLicRequestFromVars("system", "o1", []string{}, []string{}))
 	assert.NoError(t, err)
 
-	licAfter, err := service.GetLicense(getevt)
+	licAfter, err := service.GetLicense(context.Background(), getevt)
 	assert.NoError(t, err)
-	seatsAfter, err := service.GetAssignedSeats(getevt)
+	seatsAfter, err := service.GetAssignedSeats(context.Background(), getevt)
 	assert.NoError(t, err)
 
 	assert.Equal(t, licBefore.InUse, licAfter.InUse)
@@ -293,17 +294,17 @@ func TestModifySeatsRequestWithSwappingSameUser(t *testing.T) {
 		OrgID:     "o1",
 		ServiceID: "smarts",
 	}
-	licBefore, err := service.GetLicense(getevt)
+	licBefore, err := service.GetLicense(context.Background(), getevt)
 	assert.NoError(t, err)
-	seatsBefore, err := service.GetAssignedSeats(getevt)
+	seatsBefore, err := service.GetAssignedSeats(context.Background(), getevt)
 	assert.NoError(t, err)
 
-	err = service.ModifySeats(modifyLicRequestFromVars("system", "o1", []string{"noone_in_particular"}, []string{"noone_in_particular"}))
+	err = service.ModifySeats(context.Background(), modifyLicRequestFromVars("system", "o1", []string{"noone_in_particular"}, []string{"noone_in_particular"}))
 	assert.Error(t, err) //Should fail on contradicting updates: rpc error: code = InvalidArgument desc = found more than one update with relationship `license_seats:o1/smarts#assigned@user:noone_in_particular` in this request; a relationship can only be specified in an update once per overall WriteRelationships request
 
-	licAfter, err := service.GetLicense(getevt)
+	licAfter, err := service.GetLicense(context.Background(), getevt)
 	assert.NoError(t, err)
-	seatsAfter, err := service.GetAssignedSeats(getevt)
+	seatsAfter, err := service.GetAssignedSeats(context.Background(), getevt)
 	assert.NoError(t, err)
 
 	assert.Equal(t, licBefore.InUse, licAfter.InUse)
@@ -320,7 +321,7 @@ func TestCanSwapWhenLicenseFull(t *testing.T) {
 	spicedbContainer.WaitForQuantizationInterval()
 	//when
 	req := modifyLicRequestFromVars("okay", "o1", []string{"u7"}, []string{"u1"})
-	err = lic.ModifySeats(req)
+	err = lic.ModifySeats(context.Background(), req)
 	assert.NoError(t, err)
 
 	//then
@@ -332,11 +333,11 @@ func TestCanSwapWhenLicenseFull(t *testing.T) {
 		OrgID:     "o1",
 		ServiceID: "smarts",
 	}
-	license, err := lic.GetLicense(getevt)
+	license, err := lic.GetLicense(context.Background(), getevt)
 	assert.NoError(t, err)
 	assert.Equal(t, 0, license.GetAvailableSeats())
 
-	seats, err := lic.GetAssignedSeats(getevt)
+	seats, err := lic.GetAssignedSeats(context.Background(), getevt)
 	assert.NoError(t, err)
 	assert.Contains(t, seats, domain.SubjectID("u7"))
 	assert.NotContains(t, seats, domain.SubjectID("u1"))
@@ -349,11 +350,11 @@ func TestCantUnassignSeatThatWasNotAssigned(t *testing.T) {
 
 	// when
 	req := modifyLicRequestFromVars("okay", "o1", []string{}, []string{"not_assigned"})
-	err := lic.ModifySeats(req)
+	err := lic.ModifySeats(context.Background(), req)
 
 	// then
 	assert.ErrorIs(t, err, domain.ErrConflict)
-	license, err := lic.GetLicense(domain.GetLicenseEvent{
+	license, err := lic.GetLicense(context.Background(), domain.GetLicenseEvent{
 		Requestor: "okay",
 		OrgID:     "o1",
 		ServiceID: "smarts",
@@ -370,13 +371,12 @@ func fillUpLicense(lic *SeatLicenseService) error {
 
 	req := modifyLicRequestFromVars("okay", "o1", toAssign, []string{})
 
-	err := lic.ModifySeats(req)
+	err := lic.ModifySeats(context.Background(), req)
 
 	return err
 }
 
 func TestLicensingModifySeatsErrorsWhenNotAuthorized(t *testing.T) {
-	t.SkipNow() //Skip until meta-authz is in place
 	req := modifyLicRequestFromVars("bad",
 		"o1",
 		[]string{"okay"},
@@ -385,11 +385,23 @@ func TestLicensingModifySeatsErrorsWhenNotAuthorized(t *testing.T) {
 	store := spicedbSeatLicenseRepository()
 	lic := NewSeatLicenseService(store.(contracts.SeatLicenseRepository), store)
 
-	err := lic.ModifySeats(req)
+	err := lic.ModifySeats(context.Background(), req)
 
 	assert.ErrorIs(t, err, domain.ErrNotAuthorized)
 }
 
+func TestLicensingModifySeatsSucceedsWhenRequestorIsOrgAdmin(t *testing.T) {
+	req := modifyLicRequestFromVars("bad", "o1", []string{}, []string{})
+	req.RequestorIsOrgAdmin = true
+
+	store := spicedbSeatLicenseRepository()
+	lic := NewSeatLicenseService(store.(contracts.SeatLicenseRepository), store)
+
+	err := lic.ModifySeats(context.Background(), req)
+
+	assert.NoError(t, err)
+}
+
 func TestLicensingAssignUnassignRoundTrip(t *testing.T) {
 	addReq := modifyLicRequestFromVars("okay",
 		"o1",
@@ -400,16 +412,16 @@ func TestLicensingAssignUnassignRoundTrip(t *testing.T) {
 	lic := NewSeatLicenseService(store.(contracts.SeatLicenseRepository), store)
 	license := domain.Resource{Type: "license", ID: "o1/smarts"}
 
-	authz, err := store.CheckAccess(addReq.Assign[0], "access", license)
+	authz, err := store.CheckAccess(context.Background(), addReq.Assign[0], "access", license)
 	assert.NoError(t, err)
 	assert.False(t, bool(authz), "Should not have been authorized without license.")
 
-	err = lic.ModifySeats(addReq)
+	err = lic.ModifySeats(context.Background(), addReq)
 	assert.NoError(t, err)
 
 	spicedbContainer.WaitForQuantizationInterval()
 
-	authz, err = store.CheckAccess(addReq.Assign[0], "access", license)
+	authz, err = store.CheckAccess(context.Background(), addReq.Assign[0], "access", license)
 	assert.NoError(t, err)
 	assert.True(t, bool(authz), "Should have been authorized with license.")
 
@@ -418,12 +430,12 @@ func TestLicensingAssignUnassignRoundTrip(t *testing.T) {
 		[]string{},
 		[]string{"u5"})
 
-	err = lic.ModifySeats(remReq)
+	err = lic.ModifySeats(context.Background(), remReq)
 	assert.NoError(t, err)
 
 	spicedbContainer.WaitForQuantizationInterval()
 
-	authz, err = store.CheckAccess(addReq.Assign[0], "access", license)
+	authz, err = store.CheckAccess(context.Background(), addReq.Assign[0], "access", license)
 	assert.NoError(t, err)
 	assert.False(t, bool(authz), "Should not have been authorized without license.")
 }
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// SeatReclamationService unassigns the seats of disabled subjects on behalf of the system, for licenses that have seat reclamation enabled
+type SeatReclamationService struct {
+	seats contracts.SeatLicenseRepository
+}
+
+// NewSeatReclamationService constructs a new SeatReclamationService
+func NewSeatReclamationService(seats contracts.SeatLicenseRepository) *SeatReclamationService {
+	return &SeatReclamationService{seats: seats}
+}
+
+// ReclaimSeatsOfSubject reclaims the seats of the licenses of an organization that the subject holds, if they have seat reclamation enabled. The seats of other disabled subjects of these licenses are reclaimed as well.
+func (r *SeatReclamationService) ReclaimSeatsOfSubject(ctx context.Context, orgID string, subjectID domain.SubjectID) error {
+	assignments, err := r.seats.GetSeatAssignments(ctx, orgID, subjectID)
+	if err != nil {
+		return err
+	}
+
+	for _, assignment := range assignments {
+		if _, err := r.ReclaimSeats(ctx, orgID, assignment.ServiceID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReclaimSeats unassigns all disabled subjects from the license of an organization for a service, if it has seat reclamation enabled, and returns the number of seats reclaimed. It is retried if seats are modified concurrently.
+func (r *SeatReclamationService) ReclaimSeats(ctx context.Context, orgID string, serviceID string) (int, error) {
+	reclaimed := 0
+	err := retryOnConflict(func() error {
+		license, err := r.seats.GetLicense(ctx, orgID, serviceID)
+		if err != nil {
+			return err
+		}
+
+		if !license.Exists() || !license.ReclaimDisabledSeats {
+			return nil
+		}
+
+		reclaimable, err := r.seats.GetReclaimable(ctx, orgID, serviceID)
+		if err != nil {
+			return err
+		}
+
+		if len(reclaimable) == 0 {
+			return nil
+		}
+
+		if err := r.seats.ModifySeats(ctx, nil, reclaimable, license, orgID, domain.Service{ID: serviceID}); err != nil {
+			return err
+		}
+
+		reclaimed = len(reclaimable)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return reclaimed, nil
+}
+
+// Sweep reclaims the seats of disabled subjects in all licenses that have seat reclamation enabled, e.g. of subjects disabled before it was enabled. It continues with the next license after a failure and returns the number of seats reclaimed together with all failures.
+func (r *SeatReclamationService) Sweep(ctx context.Context) (int, error) {
+	licenses, err := r.seats.GetLicensesReclaimingSeats(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	reclaimed := 0
+	var errs []error
+	for _, license := range licenses {
+		count, err := r.ReclaimSeats(ctx, license.OrgID, license.ServiceID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reclaiming seats on license %s for org %s: %w", license.ServiceID, license.OrgID, err))
+		}
+		reclaimed += count
+	}
+
+	return reclaimed, errors.Join(errs...)
+}
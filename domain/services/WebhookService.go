@@ -0,0 +1,74 @@
+package services
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"context"
+)
+
+// WebhookService manages the webhooks organizations register for license events. Only subjects who may manage all licenses of an organization may manage its webhooks.
+type WebhookService struct {
+	webhooks   contracts.WebhookRepository
+	deliveries contracts.WebhookDeliveryLog
+	authz      contracts.AccessRepository
+}
+
+// NewWebhookService constructs a new WebhookService
+func NewWebhookService(webhooks contracts.WebhookRepository, deliveries contracts.WebhookDeliveryLog, authz contracts.AccessRepository) *WebhookService {
+	return &WebhookService{webhooks: webhooks, deliveries: deliveries, authz: authz}
+}
+
+// AddWebhook registers the webhook of the event under a new ID and returns it
+func (w *WebhookService) AddWebhook(ctx context.Context, evt domain.WebhookEvent) (domain.Webhook, error) {
+	if err := w.ensureRequestorCanManageWebhooks(ctx, evt); err != nil {
+		return domain.Webhook{}, err
+	}
+
+	webhook := evt.Webhook
+	webhook.ID = domain.NewWebhookID()
+
+	return webhook, w.webhooks.AddWebhook(ctx, webhook)
+}
+
+// GetWebhooks gets all webhooks of the organization of the event
+func (w *WebhookService) GetWebhooks(ctx context.Context, evt domain.WebhookEvent) ([]domain.Webhook, error) {
+	if err := w.ensureRequestorCanManageWebhooks(ctx, evt); err != nil {
+		return nil, err
+	}
+
+	return w.webhooks.GetWebhooks(ctx, evt.Webhook.OrgID)
+}
+
+// RemoveWebhook removes the webhook of the event from its organization
+func (w *WebhookService) RemoveWebhook(ctx context.Context, evt domain.WebhookEvent) error {
+	if err := w.ensureRequestorCanManageWebhooks(ctx, evt); err != nil {
+		return err
+	}
+
+	return w.webhooks.RemoveWebhook(ctx, evt.Webhook.OrgID, evt.Webhook.ID)
+}
+
+// GetDeliveries gets the recorded delivery attempts to the webhook of the event, most recent first
+func (w *WebhookService) GetDeliveries(ctx context.Context, evt domain.WebhookEvent) ([]domain.WebhookDelivery, error) {
+	if err := w.ensureRequestorCanManageWebhooks(ctx, evt); err != nil {
+		return nil, err
+	}
+
+	// Webhook IDs are unique across orgs, but deliveries must only be visible to the org of the webhook
+	webhooks, err := w.webhooks.GetWebhooks(ctx, evt.Webhook.OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, webhook := range webhooks {
+		if webhook.ID == evt.Webhook.ID {
+			return w.deliveries.GetDeliveries(ctx, webhook.ID)
+		}
+	}
+
+	return nil, domain.ErrWebhookNotFound
+}
+
+func (w *WebhookService) ensureRequestorCanManageWebhooks(ctx context.Context, evt domain.WebhookEvent) error {
+	return ensureRequestorCanManageLicenses(ctx, w.authz, evt.Requestor, evt.RequestorIsOrgAdmin, domain.Organization{ID: evt.Webhook.OrgID}, domain.Service{})
+}
@@ -0,0 +1,82 @@
+// Package metrics contains the Prometheus metrics of the service and the handler exposing them
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "authz"
+
+var (
+	// GrpcRequests counts the handled gRPC requests by method and status code
+	GrpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "Number of gRPC requests handled, including requests via the HTTP gateway, by method and status code.",
+	}, []string{"method", "code"})
+
+	// GrpcRequestDuration observes the latency of handled gRPC requests by method
+	GrpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "Latency of gRPC requests, including requests via the HTTP gateway, by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	// SpiceDbRequestDuration observes the latency of SpiceDB calls by method, streamed responses are observed until fully read
+	SpiceDbRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "spicedb_request_duration_seconds",
+		Help:      "Latency of SpiceDB calls by method, including reading streamed responses.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	// SpiceDbErrors counts failed SpiceDB calls by method and status code
+	SpiceDbErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "spicedb_errors_total",
+		Help:      "Number of failed SpiceDB calls by method and status code.",
+	}, []string{"method", "code"})
+
+	// SeatConflicts counts seat modifications rejected because the license or an assignment changed concurrently
+	SeatConflicts = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "seat_modification_conflicts_total",
+		Help:      "Number of seat assignment changes rejected with a conflict, e.g. due to concurrent changes of the same license.",
+	})
+
+	// UMBMessages counts the processed subject events from the UMB by result: success, failure, deadlettered or unparseable
+	UMBMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "umb_messages_total",
+		Help:      "Number of subject events received from the UMB by processing result.",
+	}, []string{"result"})
+
+	// UserServiceRequestDuration observes the duration of user service calls by operation and outcome
+	UserServiceRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "userservice_request_duration_seconds",
+		Help:      "Duration of user service calls by operation and outcome (success or error).",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "outcome"})
+)
+
+// Handler serves all registered metrics in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveUserServiceCall records the duration of a user service call that started at the given time
+func ObserveUserServiceCall(operation string, start time.Time, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+
+	UserServiceRequestDuration.WithLabelValues(operation, outcome).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// SpiceDbUnaryClientInterceptor records the latency and errors of unary SpiceDB calls
+func SpiceDbUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		observeSpiceDbCall(method, start, err)
+
+		return err
+	}
+}
+
+// SpiceDbStreamClientInterceptor records the latency and errors of streaming SpiceDB calls, from opening the stream until the response is fully read or fails
+func SpiceDbStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			observeSpiceDbCall(method, start, err)
+			return nil, err
+		}
+
+		return &observedClientStream{ClientStream: stream, method: method, start: start}, nil
+	}
+}
+
+// observedClientStream records the call once the first error, or the end of the stream, is received
+type observedClientStream struct {
+	grpc.ClientStream
+	method string
+	start  time.Time
+	once   sync.Once
+}
+
+func (s *observedClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.once.Do(func() {
+			if errors.Is(err, io.EOF) {
+				observeSpiceDbCall(s.method, s.start, nil)
+			} else {
+				observeSpiceDbCall(s.method, s.start, err)
+			}
+		})
+	}
+
+	return err
+}
+
+func observeSpiceDbCall(method string, start time.Time, err error) {
+	name := path.Base(method) // e.g. CheckPermission for /authzed.api.v1.PermissionsService/CheckPermission
+	SpiceDbRequestDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		SpiceDbErrors.WithLabelValues(name, status.Code(err).String()).Inc()
+	}
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamIsObservedOnceWhenFullyRead(t *testing.T) {
+	const method = "/authzed.api.v1.PermissionsService/ReadFullyTest"
+	stream := openStream(t, method, &fakeClientStream{messages: 2})
+
+	for stream.RecvMsg(nil) == nil {
+	}
+	assert.ErrorIs(t, stream.RecvMsg(nil), io.EOF)
+
+	assert.Equal(t, uint64(1), sampleCount(t, "ReadFullyTest"))
+	assert.Equal(t, float64(0), testutil.ToFloat64(SpiceDbErrors.WithLabelValues("ReadFullyTest", codes.Unknown.String())))
+}
+
+func TestFailedStreamIsCountedAsError(t *testing.T) {
+	const method = "/authzed.api.v1.PermissionsService/ReadFailureTest"
+	stream := openStream(t, method, &fakeClientStream{err: status.Error(codes.Unavailable, "connection refused")})
+
+	assert.Error(t, stream.RecvMsg(nil))
+	assert.Error(t, stream.RecvMsg(nil))
+
+	assert.Equal(t, uint64(1), sampleCount(t, "ReadFailureTest"))
+	assert.Equal(t, float64(1), testutil.ToFloat64(SpiceDbErrors.WithLabelValues("ReadFailureTest", codes.Unavailable.String())))
+}
+
+func sampleCount(t *testing.T, method string) uint64 {
+	var m dto.Metric
+	assert.NoError(t, SpiceDbRequestDuration.WithLabelValues(method).(prometheus.Histogram).Write(&m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func openStream(t *testing.T, method string, fake *fakeClientStream) grpc.ClientStream {
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return fake, nil
+	}
+
+	stream, err := SpiceDbStreamClientInterceptor()(context.Background(), &grpc.StreamDesc{ServerStreams: true}, nil, method, streamer)
+	assert.NoError(t, err)
+	return stream
+}
+
+// fakeClientStream returns the given number of messages and then io.EOF, or err if set
+type fakeClientStream struct {
+	grpc.ClientStream
+	messages int
+	err      error
+}
+
+func (s *fakeClientStream) RecvMsg(_ interface{}) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.messages == 0 {
+		return io.EOF
+	}
+	s.messages--
+	return nil
+}
@@ -0,0 +1,207 @@
+// Package audit records the license mutations of organizations
+package audit
+
+import (
+	"authz/domain"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// maxLineSize limits the size of a single record read back from a file
+const maxLineSize = 1024 * 1024
+
+// JSONLinesAuditLog writes audit records as one JSON object per line. Records written to a file can be queried by reading the file back, records written to another destination cannot.
+// A query only returns the records appended to the same file, so with more than one replica the file must be shared by all of them, on a volume whose file system supports appending from several processes.
+type JSONLinesAuditLog struct {
+	mu   sync.Mutex
+	out  io.Writer
+	file *os.File // nil unless writing to a file
+}
+
+// line is the serialized form of an audit record
+type line struct {
+	Time       time.Time `json:"time"`
+	Requestor  string    `json:"requestor,omitempty"`
+	Operation  string    `json:"operation"`
+	OrgID      string    `json:"orgId"`
+	ServiceID  string    `json:"serviceId,omitempty"`
+	Assigned   []string  `json:"assigned,omitempty"`
+	Unassigned []string  `json:"unassigned,omitempty"`
+	SubjectID  string    `json:"subjectId,omitempty"`
+	Detail     string    `json:"detail,omitempty"`
+	Outcome    string    `json:"outcome"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// NewJSONLinesAuditLog constructs a new JSONLinesAuditLog writing to out, e.g. the standard output. Its records cannot be queried.
+func NewJSONLinesAuditLog(out io.Writer) *JSONLinesAuditLog {
+	return &JSONLinesAuditLog{out: out}
+}
+
+// NewFileAuditLog constructs a new JSONLinesAuditLog appending to the file at path, which is created if it does not exist
+func NewFileAuditLog(path string) (*JSONLinesAuditLog, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSONLinesAuditLog{out: file, file: file}, nil
+}
+
+// AddRecord appends a record as a single line
+func (l *JSONLinesAuditLog) AddRecord(_ context.Context, record domain.AuditRecord) error {
+	b, err := json.Marshal(newLine(record))
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, err = l.out.Write(append(b, '\n'))
+	return err
+}
+
+// GetRecords reads the file back and returns a page of the records selected by the query in the order they were written. It fails with domain.ErrAuditLogNotQueryable unless the log writes to a file.
+// The file is read without blocking the writers. Lines that cannot be decoded are logged and skipped. Page tokens are offsets into the file, so they stay valid while records are appended.
+func (l *JSONLinesAuditLog) GetRecords(ctx context.Context, query domain.AuditQuery) (domain.AuditPage, error) {
+	if l.file == nil {
+		return domain.AuditPage{}, domain.ErrAuditLogNotQueryable
+	}
+
+	offset, err := decodePageToken(query.PageToken)
+	if err != nil {
+		return domain.AuditPage{}, err
+	}
+
+	// Reading at an offset does not affect where records are appended
+	scanner := bufio.NewScanner(io.NewSectionReader(l.file, offset, 1<<62))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	scanner.Split(scanCompleteLines)
+
+	page := domain.AuditPage{Records: make([]domain.AuditRecord, 0)}
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return domain.AuditPage{}, err
+		}
+
+		lineOffset := offset
+		offset += int64(len(scanner.Bytes())) + 1
+
+		var ln line
+		if err := json.Unmarshal(scanner.Bytes(), &ln); err != nil {
+			glog.Warningf("Skipping undecodable audit record at offset %d of %s: %v", lineOffset, l.file.Name(), err)
+			continue
+		}
+
+		record := ln.toRecord()
+		if !query.Matches(record) {
+			continue
+		}
+		if query.Limit > 0 && len(page.Records) == query.Limit {
+			// The page is full and another record matches, the next page starts with it
+			page.NextPageToken = strconv.FormatInt(lineOffset, 10)
+			break
+		}
+		page.Records = append(page.Records, record)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return domain.AuditPage{}, err
+	}
+
+	return page, nil
+}
+
+// decodePageToken returns the offset of the first record of the page, 0 for the first page
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	offset, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, domain.NewErrInvalidRequest("invalid page token")
+	}
+
+	return offset, nil
+}
+
+// scanCompleteLines splits like bufio.ScanLines, but leaves out a last line without newline, which is still being written
+func scanCompleteLines(data []byte, _ bool) (int, []byte, error) {
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+
+	return 0, nil, nil
+}
+
+// Close closes the file of the log, if any
+func (l *JSONLinesAuditLog) Close() error {
+	if l.file == nil {
+		return nil
+	}
+
+	return l.file.Close()
+}
+
+func newLine(record domain.AuditRecord) line {
+	outcome := "success"
+	if !record.Succeeded() {
+		outcome = "failure"
+	}
+
+	return line{
+		Time:       record.Time.UTC(),
+		Requestor:  string(record.Requestor),
+		Operation:  string(record.Operation),
+		OrgID:      record.OrgID,
+		ServiceID:  record.ServiceID,
+		Assigned:   fromSubjectIDs(record.Assigned),
+		Unassigned: fromSubjectIDs(record.Unassigned),
+		SubjectID:  string(record.SubjectID),
+		Detail:     record.Detail,
+		Outcome:    outcome,
+		Error:      record.Error,
+	}
+}
+
+func (ln line) toRecord() domain.AuditRecord {
+	return domain.AuditRecord{
+		Time:       ln.Time,
+		Requestor:  domain.SubjectID(ln.Requestor),
+		Operation:  domain.AuditOperation(ln.Operation),
+		OrgID:      ln.OrgID,
+		ServiceID:  ln.ServiceID,
+		Assigned:   toSubjectIDs(ln.Assigned),
+		Unassigned: toSubjectIDs(ln.Unassigned),
+		SubjectID:  domain.SubjectID(ln.SubjectID),
+		Detail:     ln.Detail,
+		Error:      ln.Error,
+	}
+}
+
+func fromSubjectIDs(ids []domain.SubjectID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = string(id)
+	}
+	return result
+}
+
+func toSubjectIDs(ids []string) []domain.SubjectID {
+	result := make([]domain.SubjectID, len(ids))
+	for i, id := range ids {
+		result[i] = domain.SubjectID(id)
+	}
+	return result
+}
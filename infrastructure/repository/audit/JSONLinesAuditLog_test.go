@@ -0,0 +1,169 @@
+package audit
+
+import (
+	"authz/domain"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var t0 = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func TestRecordsAreWrittenAsJSONLines(t *testing.T) {
+	out := &bytes.Buffer{}
+	log := NewJSONLinesAuditLog(out)
+
+	assert.NoError(t, log.AddRecord(context.Background(), domain.AuditRecord{
+		Time:       t0,
+		Requestor:  "admin",
+		Operation:  domain.AuditModifySeats,
+		OrgID:      "o1",
+		ServiceID:  "smarts",
+		Assigned:   []domain.SubjectID{"u1", "u2"},
+		Unassigned: []domain.SubjectID{"u3"},
+	}))
+	assert.NoError(t, log.AddRecord(context.Background(), domain.AuditRecord{
+		Time:      t0.Add(time.Minute),
+		Operation: domain.AuditSubjectEvent,
+		OrgID:     "o1",
+		SubjectID: "u4",
+		Error:     "unavailable",
+	}))
+
+	lines := bytes.Split(bytes.TrimSuffix(out.Bytes(), []byte("\n")), []byte("\n"))
+	assert.Len(t, lines, 2)
+	assert.JSONEq(t, `{"time":"2024-01-01T12:00:00Z","requestor":"admin","operation":"ModifySeats","orgId":"o1","serviceId":"smarts","assigned":["u1","u2"],"unassigned":["u3"],"outcome":"success"}`, string(lines[0]))
+	assert.JSONEq(t, `{"time":"2024-01-01T12:01:00Z","operation":"SubjectEvent","orgId":"o1","subjectId":"u4","outcome":"failure","error":"unavailable"}`, string(lines[1]))
+}
+
+func TestRecordsOfOtherDestinationsThanFilesCannotBeQueried(t *testing.T) {
+	log := NewJSONLinesAuditLog(&bytes.Buffer{})
+
+	_, err := log.GetRecords(context.Background(), domain.AuditQuery{OrgID: "o1"})
+
+	assert.ErrorIs(t, err, domain.ErrAuditLogNotQueryable)
+}
+
+func TestRecordsAreQueriedFromFileInWrittenOrder(t *testing.T) {
+	log, err := NewFileAuditLog(filepath.Join(t.TempDir(), "audit.jsonl"))
+	assert.NoError(t, err)
+	defer log.Close()
+
+	records := []domain.AuditRecord{
+		{Time: t0, Operation: domain.AuditEntitleOrg, OrgID: "o1", ServiceID: "smarts", Detail: "maxSeats=10"},
+		{Time: t0.Add(time.Minute), Operation: domain.AuditModifySeats, OrgID: "o2", ServiceID: "smarts", Assigned: []domain.SubjectID{"u1"}},
+		{Time: t0.Add(2 * time.Minute), Operation: domain.AuditModifySeats, OrgID: "o1", ServiceID: "smarts", Assigned: []domain.SubjectID{"u2"}, Unassigned: []domain.SubjectID{}},
+		{Time: t0.Add(3 * time.Minute), Operation: domain.AuditImportOrg, OrgID: "o1", Detail: "imported=2 notImported=0"},
+	}
+	for _, record := range records {
+		assert.NoError(t, log.AddRecord(context.Background(), record))
+	}
+
+	all, err := log.GetRecords(context.Background(), domain.AuditQuery{OrgID: "o1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.AuditOperation{domain.AuditEntitleOrg, domain.AuditModifySeats, domain.AuditImportOrg}, operations(all.Records))
+	assert.Equal(t, []domain.SubjectID{"u2"}, all.Records[1].Assigned)
+	assert.Equal(t, "maxSeats=10", all.Records[0].Detail)
+	assert.True(t, all.Records[0].Time.Equal(t0))
+
+	inRange, err := log.GetRecords(context.Background(), domain.AuditQuery{OrgID: "o1", ServiceID: "smarts", From: t0.Add(time.Minute), To: t0.Add(3 * time.Minute)})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.AuditOperation{domain.AuditModifySeats}, operations(inRange.Records))
+
+	none, err := log.GetRecords(context.Background(), domain.AuditQuery{OrgID: "o3"})
+	assert.NoError(t, err)
+	assert.Empty(t, none.Records)
+}
+
+func TestRecordsAreAppendedToExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	first, err := NewFileAuditLog(path)
+	assert.NoError(t, err)
+	assert.NoError(t, first.AddRecord(context.Background(), domain.AuditRecord{Time: t0, Operation: domain.AuditImportOrg, OrgID: "o1"}))
+	assert.NoError(t, first.Close())
+
+	second, err := NewFileAuditLog(path)
+	assert.NoError(t, err)
+	defer second.Close()
+	assert.NoError(t, second.AddRecord(context.Background(), domain.AuditRecord{Time: t0.Add(time.Minute), Operation: domain.AuditEntitleOrg, OrgID: "o1"}))
+
+	page, err := second.GetRecords(context.Background(), domain.AuditQuery{OrgID: "o1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.AuditOperation{domain.AuditImportOrg, domain.AuditEntitleOrg}, operations(page.Records))
+}
+
+func TestRecordsOfFailedOperationsKeepTheirError(t *testing.T) {
+	log, err := NewFileAuditLog(filepath.Join(t.TempDir(), "audit.jsonl"))
+	assert.NoError(t, err)
+	defer log.Close()
+	assert.NoError(t, log.AddRecord(context.Background(), domain.AuditRecord{Time: t0, Operation: domain.AuditModifySeats, OrgID: "o1", Error: "LicenseLimitExceeded"}))
+
+	page, err := log.GetRecords(context.Background(), domain.AuditQuery{OrgID: "o1"})
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Records, 1)
+	assert.False(t, page.Records[0].Succeeded())
+	assert.Equal(t, "LicenseLimitExceeded", page.Records[0].Error)
+}
+
+func TestRecordsAreQueriedInPagesThatStayValidWhileRecordsAreAppended(t *testing.T) {
+	log, err := NewFileAuditLog(filepath.Join(t.TempDir(), "audit.jsonl"))
+	assert.NoError(t, err)
+	defer log.Close()
+	for i, orgID := range []string{"o1", "o2", "o1", "o1"} {
+		assert.NoError(t, log.AddRecord(context.Background(), domain.AuditRecord{Time: t0.Add(time.Duration(i) * time.Minute), Operation: domain.AuditModifySeats, OrgID: orgID, Detail: fmt.Sprint(i)}))
+	}
+
+	first, err := log.GetRecords(context.Background(), domain.AuditQuery{OrgID: "o1", Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0", "2"}, details(first.Records))
+	assert.NotEmpty(t, first.NextPageToken)
+
+	assert.NoError(t, log.AddRecord(context.Background(), domain.AuditRecord{Time: t0.Add(time.Hour), Operation: domain.AuditModifySeats, OrgID: "o1", Detail: "4"}))
+
+	second, err := log.GetRecords(context.Background(), domain.AuditQuery{OrgID: "o1", Limit: 2, PageToken: first.NextPageToken})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3", "4"}, details(second.Records))
+	assert.Empty(t, second.NextPageToken)
+
+	_, err = log.GetRecords(context.Background(), domain.AuditQuery{OrgID: "o1", PageToken: "-1"})
+	assert.ErrorAs(t, err, &domain.ErrInvalidRequest{})
+}
+
+func TestUndecodableAndIncompleteLinesAreSkipped(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"time":"2024-01-01T12:00:00Z","operation":"ImportOrg","orgId":"o1","outcome":"success"}`+"\nnot json\n"), 0o600))
+	log, err := NewFileAuditLog(path)
+	assert.NoError(t, err)
+	defer log.Close()
+	assert.NoError(t, log.AddRecord(context.Background(), domain.AuditRecord{Time: t0.Add(time.Minute), Operation: domain.AuditEntitleOrg, OrgID: "o1"}))
+	_, err = log.file.WriteString(`{"time":"2024-01-01T12:02:00Z","operation":"Import`) // another process is still writing this record
+	assert.NoError(t, err)
+
+	page, err := log.GetRecords(context.Background(), domain.AuditQuery{OrgID: "o1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.AuditOperation{domain.AuditImportOrg, domain.AuditEntitleOrg}, operations(page.Records))
+}
+
+func details(records []domain.AuditRecord) []string {
+	result := make([]string, len(records))
+	for i, record := range records {
+		result[i] = record.Detail
+	}
+	return result
+}
+
+func operations(records []domain.AuditRecord) []domain.AuditOperation {
+	result := make([]domain.AuditOperation, len(records))
+	for i, record := range records {
+		result[i] = record.Operation
+	}
+	return result
+}
@@ -4,21 +4,26 @@ package authzed
 import (
 	"authz/domain"
 	"authz/infrastructure/grpcutil"
+	"authz/infrastructure/metrics"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/glog"
 
 	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
 	"github.com/authzed/authzed-go/v1"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const (
@@ -30,26 +35,62 @@ const (
 	LicenseSeatObjectType = "license_seats"
 	// LicenseObjectType - License relation
 	LicenseObjectType = "license"
+	// LicenseAccessPermission - permission to use a license, only granted within the term of the license
+	LicenseAccessPermission = "access"
+	// LicenseAccessInTermPermission - the access permission restricted to the term of the license, checked for LicenseAccessPermission
+	LicenseAccessInTermPermission = "access_in_term"
 	// LicenseVersionStr - License Version relation
 	LicenseVersionStr = "version"
+	// LicenseStartStr - License term start relation
+	LicenseStartStr = "start"
+	// LicenseEndStr - License term end relation
+	LicenseEndStr = "end"
+	// LicenseOutOfTermStr - relation of licenses with a term to all users, caveated with LicenseTermCaveat so that it only holds outside of the term
+	LicenseOutOfTermStr = "out_of_term"
+	// LicenseTermCaveat - caveat of the out_of_term relation, given the start and end of the term by the relationship and now by the check
+	LicenseTermCaveat = "outside_license_term"
+	// TimestampType - timestamp object type, IDs are unix seconds
+	TimestampType = "timestamp"
+	// SeatObjectType - seat relation, IDs are <orgID>/<serviceID>/<subjectID>
+	SeatObjectType = "seat"
+	// SeatAssignedAtStr - seat assignment time relation
+	SeatAssignedAtStr = "assigned_at"
+	// LicenseAdminStr - license admin relation, grants the permission to manage a single license
+	LicenseAdminStr = "license_admin"
+	// LicenseReclaimStr - seat reclamation relation, present if seats of disabled users are unassigned automatically
+	LicenseReclaimStr = "reclaim"
+	// ReclaimPolicyType - reclaim_policy object type, the only policy is ReclaimDisabledID
+	ReclaimPolicyType = "reclaim_policy"
+	// ReclaimDisabledID - reclaim_policy ID for reclaiming the seats of disabled users
+	ReclaimDisabledID = "disabled"
 )
 
+// deleteBatchSize limits the relationships deleted per request, which SpiceDB limits to 1000 by default
+const deleteBatchSize = 500
+
 // SpiceDbAccessRepository -
 type SpiceDbAccessRepository struct {
-	client    *authzed.Client
-	ctx       context.Context
-	CurrToken string
+	client        *authzed.Client
+	CurrToken     string
+	outboxEnabled bool
 }
 
 // CheckAccess - verify permission with subject type "user"
-func (s *SpiceDbAccessRepository) CheckAccess(subjectID domain.SubjectID, operation string, resource domain.Resource) (domain.AccessDecision, error) {
+func (s *SpiceDbAccessRepository) CheckAccess(ctx context.Context, subjectID domain.SubjectID, operation string, resource domain.Resource) (domain.AccessDecision, error) {
 	subject, object := createSubjectObjectTuple(SubjectType, string(subjectID), resource.Type, resource.ID)
 
-	result, err := s.client.CheckPermission(s.ctx, &v1.CheckPermissionRequest{
+	req := &v1.CheckPermissionRequest{
 		Resource:   object,
 		Permission: operation,
 		Subject:    subject,
-	})
+	}
+	if resource.Type == LicenseObjectType && operation == LicenseAccessPermission {
+		// Licenses deny access outside of their term. Other permissions on licenses, like managing them, are granted regardless of the term.
+		req.Permission = LicenseAccessInTermPermission
+		req.Context = &structpb.Struct{Fields: map[string]*structpb.Value{"now": structpb.NewStringValue(formatCaveatTimestamp(time.Now()))}}
+	}
+
+	result, err := s.client.CheckPermission(ctx, req)
 
 	if err != nil {
 		glog.Errorf("Failed to check permission :%v", err.Error())
@@ -64,13 +105,134 @@ func (s *SpiceDbAccessRepository) CheckAccess(subjectID domain.SubjectID, operat
 	return false, nil
 }
 
+// setLicenseTerm sets the start or end date of the license if the relation is one of them
+func setLicenseTerm(license *domain.License, relation string, timestamp string) error {
+	if relation != LicenseStartStr && relation != LicenseEndStr {
+		return nil
+	}
+
+	date, err := parseTimestamp(timestamp)
+	if err != nil {
+		return fmt.Errorf("invalid license %s %s", relation, timestamp)
+	}
+
+	if relation == LicenseStartStr {
+		license.StartDate = date
+	} else {
+		license.EndDate = date
+	}
+	return nil
+}
+
+func parseTimestamp(timestamp string) (time.Time, error) {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %s", timestamp)
+	}
+
+	return time.Unix(seconds, 0).UTC(), nil
+}
+
+func createLicenseTermRelationshipUpdate(licenseResource *v1.ObjectReference, relation string, date time.Time) *v1.RelationshipUpdate {
+	return &v1.RelationshipUpdate{
+		Operation: v1.RelationshipUpdate_OPERATION_CREATE,
+		Relationship: &v1.Relationship{
+			Resource: licenseResource,
+			Relation: relation,
+			Subject: &v1.SubjectReference{
+				Object: &v1.ObjectReference{
+					ObjectType: TimestampType,
+					ObjectId:   strconv.FormatInt(date.Unix(), 10),
+				},
+			},
+		},
+	}
+}
+
+// createOutOfTermRelationship returns the relationship that denies access to a license outside of its term, nil if the license has no term
+func createOutOfTermRelationship(licenseResource *v1.ObjectReference, license *domain.License) *v1.Relationship {
+	if license.StartDate.IsZero() && license.EndDate.IsZero() {
+		return nil
+	}
+
+	// The caveat needs both bounds, open ones are the limits of CEL timestamps
+	start, end := license.StartDate, license.EndDate
+	if start.IsZero() {
+		start = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	if end.IsZero() {
+		end = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+	}
+
+	return &v1.Relationship{
+		Resource: licenseResource,
+		Relation: LicenseOutOfTermStr,
+		Subject:  &v1.SubjectReference{Object: &v1.ObjectReference{ObjectType: SubjectType, ObjectId: "*"}},
+		OptionalCaveat: &v1.ContextualizedCaveat{
+			CaveatName: LicenseTermCaveat,
+			Context: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"start": structpb.NewStringValue(formatCaveatTimestamp(start)),
+				"end":   structpb.NewStringValue(formatCaveatTimestamp(end)),
+			}},
+		},
+	}
+}
+
+// updateOutOfTermRelationships derives the out_of_term relationships of the licenses from their start and end, for terms written by other means than StoreLicense. Licenses without term lose theirs.
+func (s *SpiceDbAccessRepository) updateOutOfTermRelationships(ctx context.Context, licenseIDs []string) error {
+	updates := make([]*v1.RelationshipUpdate, 0, len(licenseIDs))
+	for _, licenseID := range licenseIDs {
+		rels, err := s.readRelationships(ctx, &v1.RelationshipFilter{ResourceType: LicenseObjectType, OptionalResourceId: licenseID})
+		if err != nil {
+			return err
+		}
+
+		var license domain.License
+		for _, rel := range rels {
+			if err := setLicenseTerm(&license, rel.Relation, rel.Subject.Object.ObjectId); err != nil {
+				return err
+			}
+		}
+
+		licenseResource := &v1.ObjectReference{ObjectType: LicenseObjectType, ObjectId: licenseID}
+		if rel := createOutOfTermRelationship(licenseResource, &license); rel != nil {
+			updates = append(updates, &v1.RelationshipUpdate{Operation: v1.RelationshipUpdate_OPERATION_TOUCH, Relationship: rel})
+		} else {
+			updates = append(updates, &v1.RelationshipUpdate{Operation: v1.RelationshipUpdate_OPERATION_DELETE, Relationship: &v1.Relationship{
+				Resource: licenseResource,
+				Relation: LicenseOutOfTermStr,
+				Subject:  &v1.SubjectReference{Object: &v1.ObjectReference{ObjectType: SubjectType, ObjectId: "*"}},
+			}})
+		}
+	}
+
+	for len(updates) > 0 {
+		n := len(updates)
+		if n > deleteBatchSize {
+			n = deleteBatchSize
+		}
+		if _, err := s.client.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{Updates: updates[:n]}); err != nil {
+			return spiceDbErrorToDomainError(err)
+		}
+		updates = updates[n:]
+	}
+
+	return nil
+}
+
+// formatCaveatTimestamp formats a point in time as caveat parameter of type timestamp
+func formatCaveatTimestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
 // ModifySeats atomically persists changes to seat assignments for a license
-func (s *SpiceDbAccessRepository) ModifySeats(assignedSubjectIDs []domain.SubjectID, removedSubjectIDs []domain.SubjectID, license *domain.License, orgID string, svc domain.Service) error {
+func (s *SpiceDbAccessRepository) ModifySeats(ctx context.Context, assignedSubjectIDs []domain.SubjectID, removedSubjectIDs []domain.SubjectID, license *domain.License, orgID string, svc domain.Service) error {
 	// Step 1 Add seat changes
 	var relationshipUpdates []*v1.RelationshipUpdate
 
 	var preconditions []*v1.Precondition
 	assignedCount := license.InUse
+	now := time.Now()
 
 	for _, subj := range assignedSubjectIDs {
 		relationshipUpdates = append(relationshipUpdates, createUserSeatAssignmentRelationshipUpdate(
@@ -78,6 +240,7 @@ func (s *SpiceDbAccessRepository) ModifySeats(assignedSubjectIDs []domain.Subjec
 			subj,
 			orgID,
 			svc))
+		relationshipUpdates = append(relationshipUpdates, createSeatAssignedAtRelationshipUpdate(subj, orgID, svc, now))
 		preconditions = append(preconditions, createUserNotDisabledPrecondition(subj, orgID), createUserIsMemberOfOrgPrecondition(subj, orgID))
 
 		assignedCount++
@@ -91,14 +254,30 @@ func (s *SpiceDbAccessRepository) ModifySeats(assignedSubjectIDs []domain.Subjec
 			svc))
 		preconditions = append(preconditions, createSeatAssignedPrecondition(subj, orgID, svc))
 
+		assignedAtRels, err := s.readRelationships(ctx, &v1.RelationshipFilter{ResourceType: SeatObjectType, OptionalResourceId: createSeatID(orgID, svc.ID, subj)})
+		if err != nil {
+			return err
+		}
+		for _, rel := range assignedAtRels {
+			relationshipUpdates = append(relationshipUpdates, &v1.RelationshipUpdate{Operation: v1.RelationshipUpdate_OPERATION_DELETE, Relationship: rel})
+		}
+
 		assignedCount--
 	}
 
 	// Step 2 Add license changes
 	relationshipUpdates, preconditions = addLicenseVersionSwap(relationshipUpdates, preconditions, license, assignedCount)
+
+	updated := *license
+	updated.InUse = assignedCount
+	relationshipUpdates, err := s.addOutboxEvents(relationshipUpdates, domain.NewSeatEvents(&updated, assignedSubjectIDs, removedSubjectIDs))
+	if err != nil {
+		return err
+	}
+
 	glog.Infof("Trying to assign %s and unassign %s seats on license %s for org %s with %d of %d seats currently in use.", assignedSubjectIDs, removedSubjectIDs, license.ServiceID, license.OrgID, license.InUse, license.MaxSeats)
 	// Step 3 submit transaction
-	result, err := s.client.WriteRelationships(s.ctx, &v1.WriteRelationshipsRequest{
+	result, err := s.client.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
 		Updates:               relationshipUpdates,
 		OptionalPreconditions: preconditions,
 	})
@@ -107,7 +286,11 @@ func (s *SpiceDbAccessRepository) ModifySeats(assignedSubjectIDs []domain.Subjec
 	if err != nil {
 		glog.Errorf("Error assigning %s / unassigning %s seats on license %s for org %s with %d of %d seats currently in use.\nInternal error: %v", assignedSubjectIDs, removedSubjectIDs, license.ServiceID, license.OrgID, license.InUse, license.MaxSeats, err.Error())
 
-		return spiceDbErrorToDomainError(err)
+		err = spiceDbErrorToDomainError(err)
+		if errors.Is(err, domain.ErrConflict) {
+			metrics.SeatConflicts.Inc()
+		}
+		return err
 	}
 
 	glog.Infof("Successfully assigned %s / unassigned %s seats on license %s for org %s. Current seats used: %d of %d\n Internal response: %v", assignedSubjectIDs, removedSubjectIDs, license.ServiceID, license.OrgID, assignedCount, license.MaxSeats, result)
@@ -125,6 +308,21 @@ func createUserSeatAssignmentRelationshipUpdate(operation v1.RelationshipUpdate_
 		}}
 }
 
+func createSeatAssignedAtRelationshipUpdate(subj domain.SubjectID, orgID string, svc domain.Service, assignedAt time.Time) *v1.RelationshipUpdate {
+	return &v1.RelationshipUpdate{
+		Operation: v1.RelationshipUpdate_OPERATION_CREATE,
+		Relationship: &v1.Relationship{
+			Resource: &v1.ObjectReference{ObjectType: SeatObjectType, ObjectId: createSeatID(orgID, svc.ID, subj)},
+			Relation: SeatAssignedAtStr,
+			Subject:  &v1.SubjectReference{Object: &v1.ObjectReference{ObjectType: TimestampType, ObjectId: strconv.FormatInt(assignedAt.Unix(), 10)}},
+		},
+	}
+}
+
+func createSeatID(orgID string, serviceID string, subj domain.SubjectID) string {
+	return fmt.Sprintf("%s/%s/%s", orgID, serviceID, subj)
+}
+
 func addLicenseVersionSwap(updates []*v1.RelationshipUpdate, conditions []*v1.Precondition, lic *domain.License, newCount int) ([]*v1.RelationshipUpdate, []*v1.Precondition) {
 	licenseObj := createObjectFromLicense(lic)
 	oldVersionSubj := createSubjectFromLicenseAndCount(lic, lic.InUse)
@@ -229,9 +427,9 @@ func createUserNotDisabledPrecondition(subj domain.SubjectID, orgID string) *v1.
 }
 
 // GetLicense - Get the current license infoarmation
-func (s *SpiceDbAccessRepository) GetLicense(orgID string, serviceID string) (*domain.License, error) {
+func (s *SpiceDbAccessRepository) GetLicense(ctx context.Context, orgID string, serviceID string) (*domain.License, error) {
 	var license domain.License
-	resp, err := s.client.ReadRelationships(s.ctx, &v1.ReadRelationshipsRequest{
+	resp, err := s.client.ReadRelationships(ctx, &v1.ReadRelationshipsRequest{
 		Consistency: &v1.Consistency{Requirement: &v1.Consistency_FullyConsistent{FullyConsistent: true}},
 		RelationshipFilter: &v1.RelationshipFilter{
 			ResourceType:       LicenseObjectType,
@@ -278,6 +476,12 @@ func (s *SpiceDbAccessRepository) GetLicense(orgID string, serviceID string) (*d
 			license.InUse = currentAssignedCount
 			license.Version = versionStrArr[0]
 		}
+		if v.Relationship.Relation == LicenseReclaimStr {
+			license.ReclaimDisabledSeats = true
+		}
+		if err := setLicenseTerm(&license, v.Relationship.Relation, v.Relationship.Subject.Object.ObjectId); err != nil {
+			return nil, err
+		}
 		license.OrgID = orgID
 		license.ServiceID = serviceID
 	}
@@ -285,10 +489,99 @@ func (s *SpiceDbAccessRepository) GetLicense(orgID string, serviceID string) (*d
 	return &license, nil
 }
 
+// GetLicenses retrieves all licenses applied to the given organization, ordered by service ID
+func (s *SpiceDbAccessRepository) GetLicenses(ctx context.Context, orgID string) ([]*domain.License, error) {
+	rels, err := s.readRelationships(ctx, &v1.RelationshipFilter{
+		ResourceType:     LicenseObjectType,
+		OptionalRelation: OrgType,
+		OptionalSubjectFilter: &v1.SubjectFilter{
+			SubjectType:       OrgType,
+			OptionalSubjectId: orgID,
+		},
+	})
+
+	if err != nil {
+		glog.Errorf("Failed to read License relation :%v", err.Error())
+		return nil, err
+	}
+
+	serviceIDs := make([]string, 0, len(rels))
+	for _, rel := range rels {
+		serviceIDs = append(serviceIDs, strings.TrimPrefix(rel.Resource.ObjectId, orgID+"/"))
+	}
+	sort.Strings(serviceIDs)
+
+	licenses := make([]*domain.License, 0, len(serviceIDs))
+	for _, serviceID := range serviceIDs {
+		license, err := s.GetLicense(ctx, orgID, serviceID)
+		if err != nil {
+			return nil, err
+		}
+
+		licenses = append(licenses, license)
+	}
+
+	return licenses, nil
+}
+
+// GetSeatAssignments retrieves the seats the given subject holds in licenses of the given organization, ordered by service ID
+func (s *SpiceDbAccessRepository) GetSeatAssignments(ctx context.Context, orgID string, subjectID domain.SubjectID) ([]domain.SeatAssignment, error) {
+	result, err := s.client.LookupResources(ctx, &v1.LookupResourcesRequest{
+		Consistency:        &v1.Consistency{Requirement: &v1.Consistency_FullyConsistent{FullyConsistent: true}},
+		ResourceObjectType: LicenseObjectType,
+		Permission:         "access",
+		Subject: &v1.SubjectReference{Object: &v1.ObjectReference{
+			ObjectType: SubjectType,
+			ObjectId:   string(subjectID),
+		}},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var serviceIDs []string
+	for {
+		next, err := result.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Licenses of all organizations are returned, the subject may only hold seats in its own though
+		if serviceID, ok := strings.CutPrefix(next.ResourceObjectId, orgID+"/"); ok {
+			serviceIDs = append(serviceIDs, serviceID)
+		}
+	}
+	sort.Strings(serviceIDs)
+
+	assignments := make([]domain.SeatAssignment, 0, len(serviceIDs))
+	for _, serviceID := range serviceIDs {
+		assignment := domain.SeatAssignment{OrgID: orgID, ServiceID: serviceID, SubjectID: subjectID}
+
+		rels, err := s.readRelationships(ctx, &v1.RelationshipFilter{ResourceType: SeatObjectType, OptionalResourceId: createSeatID(orgID, serviceID, subjectID), OptionalRelation: SeatAssignedAtStr})
+		if err != nil {
+			return nil, err
+		}
+		if len(rels) > 0 {
+			assignment.AssignedAt, err = parseTimestamp(rels[0].Subject.Object.ObjectId)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments, nil
+}
+
 // HasAnyLicense returns true if an org has at least one license applied, false otherwise for orgs without licenses or unknown orgs
-func (s *SpiceDbAccessRepository) HasAnyLicense(orgID string) (bool, error) {
+func (s *SpiceDbAccessRepository) HasAnyLicense(ctx context.Context, orgID string) (bool, error) {
 
-	resp, err := s.client.ReadRelationships(s.ctx, &v1.ReadRelationshipsRequest{
+	resp, err := s.client.ReadRelationships(ctx, &v1.ReadRelationshipsRequest{
 		Consistency: &v1.Consistency{Requirement: &v1.Consistency_FullyConsistent{FullyConsistent: true}},
 		RelationshipFilter: &v1.RelationshipFilter{
 			ResourceType: LicenseObjectType,
@@ -320,8 +613,8 @@ func (s *SpiceDbAccessRepository) HasAnyLicense(orgID string) (bool, error) {
 }
 
 // GetAssignable returns assignable seats for a given organization ID and service ID (which are not already assigned)
-func (s *SpiceDbAccessRepository) GetAssignable(orgID string, serviceID string) ([]domain.SubjectID, error) {
-	result, err := s.client.LookupSubjects(s.ctx, &v1.LookupSubjectsRequest{
+func (s *SpiceDbAccessRepository) GetAssignable(ctx context.Context, orgID string, serviceID string) ([]domain.SubjectID, error) {
+	result, err := s.client.LookupSubjects(ctx, &v1.LookupSubjectsRequest{
 		Resource: &v1.ObjectReference{
 			ObjectType: LicenseObjectType,
 			ObjectId:   fmt.Sprintf("%s/%s", orgID, serviceID),
@@ -346,12 +639,14 @@ func (s *SpiceDbAccessRepository) GetAssignable(orgID string, serviceID string)
 
 		ids = append(ids, domain.SubjectID(next.Subject.SubjectObjectId))
 	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
 	return ids, nil
 }
 
 // GetAssigned returns assigned seats for a given organization ID and service ID
-func (s *SpiceDbAccessRepository) GetAssigned(orgID string, serviceID string) ([]domain.SubjectID, error) {
-	result, err := s.client.LookupSubjects(s.ctx, &v1.LookupSubjectsRequest{
+func (s *SpiceDbAccessRepository) GetAssigned(ctx context.Context, orgID string, serviceID string) ([]domain.SubjectID, error) {
+	result, err := s.client.LookupSubjects(ctx, &v1.LookupSubjectsRequest{
 		Resource: &v1.ObjectReference{
 			ObjectType: LicenseObjectType,
 			ObjectId:   fmt.Sprintf("%s/%s", orgID, serviceID),
@@ -376,86 +671,481 @@ func (s *SpiceDbAccessRepository) GetAssigned(orgID string, serviceID string) ([
 
 		ids = append(ids, domain.SubjectID(next.Subject.SubjectObjectId))
 	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// GetReclaimable returns the disabled subjects still assigned seats for a given organization ID and service ID
+func (s *SpiceDbAccessRepository) GetReclaimable(ctx context.Context, orgID string, serviceID string) ([]domain.SubjectID, error) {
+	// Subjects are usually reclaimed right after they were disabled, so the lookup must see that change
+	result, err := s.client.LookupSubjects(ctx, &v1.LookupSubjectsRequest{
+		Consistency: &v1.Consistency{Requirement: &v1.Consistency_FullyConsistent{FullyConsistent: true}},
+		Resource: &v1.ObjectReference{
+			ObjectType: LicenseObjectType,
+			ObjectId:   fmt.Sprintf("%s/%s", orgID, serviceID),
+		},
+		Permission:        "reclaimable",
+		SubjectObjectType: SubjectType,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]domain.SubjectID, 0)
+	for {
+		next, err := result.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, domain.SubjectID(next.Subject.SubjectObjectId))
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
 	return ids, nil
 }
 
 // ApplyLicense stores the given license associated with its service and organization
-func (s *SpiceDbAccessRepository) ApplyLicense(license *domain.License) error {
+func (s *SpiceDbAccessRepository) ApplyLicense(ctx context.Context, license *domain.License) error {
 	licenseID := fmt.Sprintf("%s/%s", license.OrgID, license.ServiceID)
 	licenseResource := &v1.ObjectReference{
 		ObjectType: LicenseObjectType,
 		ObjectId:   licenseID,
 	}
 
-	_, err := s.client.WriteRelationships(s.ctx, &v1.WriteRelationshipsRequest{
-		Updates: []*v1.RelationshipUpdate{
-			{
-				Operation: v1.RelationshipUpdate_OPERATION_CREATE,
-				Relationship: &v1.Relationship{
-					Resource: licenseResource,
-					Relation: "max",
-					Subject: &v1.SubjectReference{
-						Object: &v1.ObjectReference{
-							ObjectType: "max",
-							ObjectId:   strconv.Itoa(license.MaxSeats),
-						},
+	updates := []*v1.RelationshipUpdate{
+		{
+			Operation: v1.RelationshipUpdate_OPERATION_CREATE,
+			Relationship: &v1.Relationship{
+				Resource: licenseResource,
+				Relation: "max",
+				Subject: &v1.SubjectReference{
+					Object: &v1.ObjectReference{
+						ObjectType: "max",
+						ObjectId:   strconv.Itoa(license.MaxSeats),
 					},
 				},
 			},
-			{
-				Operation: v1.RelationshipUpdate_OPERATION_CREATE,
-				Relationship: &v1.Relationship{
-					Resource: licenseResource,
-					Relation: "seats",
-					Subject: &v1.SubjectReference{
-						Object: &v1.ObjectReference{
-							ObjectType: LicenseSeatObjectType,
-							ObjectId:   licenseID,
-						},
+		},
+		{
+			Operation: v1.RelationshipUpdate_OPERATION_CREATE,
+			Relationship: &v1.Relationship{
+				Resource: licenseResource,
+				Relation: "seats",
+				Subject: &v1.SubjectReference{
+					Object: &v1.ObjectReference{
+						ObjectType: LicenseSeatObjectType,
+						ObjectId:   licenseID,
 					},
 				},
 			},
-			{
-				Operation: v1.RelationshipUpdate_OPERATION_CREATE,
-				Relationship: &v1.Relationship{
-					Resource: licenseResource,
-					Relation: "version",
-					Subject: &v1.SubjectReference{
-						Object: &v1.ObjectReference{
-							ObjectType: LicenseVersionStr,
-							ObjectId:   fmt.Sprintf("%s/%d", license.Version, license.InUse),
-						},
+		},
+		{
+			Operation: v1.RelationshipUpdate_OPERATION_CREATE,
+			Relationship: &v1.Relationship{
+				Resource: licenseResource,
+				Relation: "version",
+				Subject: &v1.SubjectReference{
+					Object: &v1.ObjectReference{
+						ObjectType: LicenseVersionStr,
+						ObjectId:   fmt.Sprintf("%s/%d", license.Version, license.InUse),
 					},
 				},
 			},
-			{
-				Operation: v1.RelationshipUpdate_OPERATION_CREATE,
-				Relationship: &v1.Relationship{
-					Resource: licenseResource,
-					Relation: "org",
-					Subject: &v1.SubjectReference{
-						Object: &v1.ObjectReference{
-							ObjectType: OrgType,
-							ObjectId:   license.OrgID,
-						},
+		},
+		{
+			Operation: v1.RelationshipUpdate_OPERATION_CREATE,
+			Relationship: &v1.Relationship{
+				Resource: licenseResource,
+				Relation: "org",
+				Subject: &v1.SubjectReference{
+					Object: &v1.ObjectReference{
+						ObjectType: OrgType,
+						ObjectId:   license.OrgID,
 					},
 				},
 			},
 		},
+	}
+
+	if !license.StartDate.IsZero() {
+		updates = append(updates, createLicenseTermRelationshipUpdate(licenseResource, LicenseStartStr, license.StartDate))
+	}
+	if !license.EndDate.IsZero() {
+		updates = append(updates, createLicenseTermRelationshipUpdate(licenseResource, LicenseEndStr, license.EndDate))
+	}
+	if rel := createOutOfTermRelationship(licenseResource, license); rel != nil {
+		updates = append(updates, &v1.RelationshipUpdate{Operation: v1.RelationshipUpdate_OPERATION_CREATE, Relationship: rel})
+	}
+
+	updates, err := s.addOutboxEvents(updates, []domain.LicenseEvent{domain.NewLicenseEntitledEvent(license)})
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
+		Updates: updates,
 		OptionalPreconditions: []*v1.Precondition{{
 			Operation: v1.Precondition_OPERATION_MUST_NOT_MATCH,
 			Filter: &v1.RelationshipFilter{
 				ResourceType:       licenseResource.ObjectType,
 				OptionalResourceId: licenseResource.ObjectId,
 			},
+		}, {
+			// Seat assignments left over by a failed revocation of the license must not be taken over
+			Operation: v1.Precondition_OPERATION_MUST_NOT_MATCH,
+			Filter: &v1.RelationshipFilter{
+				ResourceType:       LicenseSeatObjectType,
+				OptionalResourceId: licenseID,
+			},
 		}},
 	})
 
-	return err
+	return spiceDbErrorToDomainError(err)
+}
+
+// UpdateLicense atomically replaces the seat limit and version of a stored license
+func (s *SpiceDbAccessRepository) UpdateLicense(ctx context.Context, current *domain.License, updated *domain.License) error {
+	licenseObj := createObjectFromLicense(current)
+	oldMaxSubj := createMaxSubjectFromLicense(current)
+	oldVersionSubj := createSubjectFromLicenseAndCount(current, current.InUse)
+
+	// Both the current limit and version must still be stored, otherwise the license was changed concurrently
+	preconditions := []*v1.Precondition{
+		createLicenseRelationPrecondition(licenseObj, "max", oldMaxSubj),
+		createLicenseRelationPrecondition(licenseObj, LicenseVersionStr, oldVersionSubj),
+	}
+
+	updates := []*v1.RelationshipUpdate{
+		{
+			Operation:    v1.RelationshipUpdate_OPERATION_DELETE,
+			Relationship: &v1.Relationship{Resource: licenseObj, Relation: "max", Subject: oldMaxSubj},
+		},
+		{
+			Operation:    v1.RelationshipUpdate_OPERATION_CREATE,
+			Relationship: &v1.Relationship{Resource: licenseObj, Relation: "max", Subject: createMaxSubjectFromLicense(updated)},
+		},
+		{
+			Operation:    v1.RelationshipUpdate_OPERATION_DELETE,
+			Relationship: &v1.Relationship{Resource: licenseObj, Relation: LicenseVersionStr, Subject: oldVersionSubj},
+		},
+		{
+			Operation:    v1.RelationshipUpdate_OPERATION_CREATE,
+			Relationship: &v1.Relationship{Resource: licenseObj, Relation: LicenseVersionStr, Subject: createSubjectFromLicenseAndCount(updated, updated.InUse)},
+		},
+	}
+
+	updates, err := s.addOutboxEvents(updates, []domain.LicenseEvent{domain.NewLicenseUpdatedEvent(current, updated)})
+	if err != nil {
+		return err
+	}
+
+	glog.Infof("Trying to change seats on license %s for org %s from %d to %d with %d seats currently in use.", current.ServiceID, current.OrgID, current.MaxSeats, updated.MaxSeats, current.InUse)
+	_, err = s.client.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
+		Updates:               updates,
+		OptionalPreconditions: preconditions,
+	})
+
+	if err != nil {
+		glog.Errorf("Error changing seats on license %s for org %s from %d to %d.\nInternal error: %v", current.ServiceID, current.OrgID, current.MaxSeats, updated.MaxSeats, err.Error())
+		return spiceDbErrorToDomainError(err)
+	}
+
+	return nil
+}
+
+// RevokeLicense removes a license together with all its seat assignments. The license itself is removed atomically, so its seats cannot be used or changed afterwards.
+// Its seat assignments are removed in batches afterwards. If that fails, revoking the license again removes the remaining assignments, and entitling the org again fails until it did.
+func (s *SpiceDbAccessRepository) RevokeLicense(ctx context.Context, orgID string, serviceID string) error {
+	licenseID := fmt.Sprintf("%s/%s", orgID, serviceID)
+
+	licenseRels, err := s.readRelationships(ctx, &v1.RelationshipFilter{ResourceType: LicenseObjectType, OptionalResourceId: licenseID})
+	if err != nil {
+		return err
+	}
+
+	if len(licenseRels) == 0 {
+		// Seat assignments left over by a revocation that failed halfway are removed all the same
+		removed, err := s.removeSeatsOfLicense(ctx, orgID, serviceID)
+		if err != nil || removed > 0 {
+			return err
+		}
+		return domain.ErrLicenseNotFound
+	}
+
+	var updates []*v1.RelationshipUpdate
+	var preconditions []*v1.Precondition
+	maxSeats := 0
+	for _, rel := range licenseRels {
+		updates = append(updates, &v1.RelationshipUpdate{
+			Operation:    v1.RelationshipUpdate_OPERATION_DELETE,
+			Relationship: rel,
+		})
+
+		if rel.Relation == "max" {
+			maxSeats, _ = strconv.Atoi(rel.Subject.Object.ObjectId)
+		}
+
+		// Seats assigned or unassigned in the meantime swap the version, so it must still be the one read above
+		if rel.Relation == LicenseVersionStr {
+			preconditions = append(preconditions, createLicenseRelationPrecondition(rel.Resource, LicenseVersionStr, rel.Subject))
+		}
+	}
+
+	// The event is recorded with the removal of the license, the seat assignments removed afterwards belong to it
+	updates, err = s.addOutboxEvents(updates, []domain.LicenseEvent{domain.NewLicenseRevokedEvent(orgID, serviceID, maxSeats)})
+	if err != nil {
+		return err
+	}
+
+	glog.Infof("Trying to revoke license %s for org %s.", serviceID, orgID)
+	_, err = s.client.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
+		Updates:               updates,
+		OptionalPreconditions: preconditions,
+	})
+
+	if err != nil {
+		glog.Errorf("Error revoking license %s for org %s.\nInternal error: %v", serviceID, orgID, err.Error())
+		return spiceDbErrorToDomainError(err)
+	}
+
+	removed, err := s.removeSeatsOfLicense(ctx, orgID, serviceID)
+	if err != nil {
+		glog.Errorf("Error removing seat assignments of revoked license %s for org %s, revoke it again to remove the remaining ones.\nInternal error: %v", serviceID, orgID, err.Error())
+		return err
+	}
+
+	glog.Infof("Revoked license %s for org %s and removed %d seat assignments.", serviceID, orgID, removed)
+	return nil
+}
+
+// removeSeatsOfLicense deletes the seat assignments of a license in batches, the assignment times of the seats first, and returns the number of assignments removed
+func (s *SpiceDbAccessRepository) removeSeatsOfLicense(ctx context.Context, orgID string, serviceID string) (int, error) {
+	licenseID := fmt.Sprintf("%s/%s", orgID, serviceID)
+
+	seatRels, err := s.readRelationships(ctx, &v1.RelationshipFilter{ResourceType: LicenseSeatObjectType, OptionalResourceId: licenseID})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, rel := range seatRels {
+		seatID := createSeatID(orgID, serviceID, domain.SubjectID(rel.Subject.Object.ObjectId))
+		if err := s.deleteRelationships(ctx, &v1.RelationshipFilter{ResourceType: SeatObjectType, OptionalResourceId: seatID}); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := s.deleteRelationships(ctx, &v1.RelationshipFilter{ResourceType: LicenseSeatObjectType, OptionalResourceId: licenseID}); err != nil {
+		return 0, err
+	}
+
+	return len(seatRels), nil
+}
+
+// deleteRelationships deletes all relationships matching the filter in batches of deleteBatchSize, so that the number of matches is not limited by SpiceDB
+func (s *SpiceDbAccessRepository) deleteRelationships(ctx context.Context, filter *v1.RelationshipFilter) error {
+	for {
+		resp, err := s.client.DeleteRelationships(ctx, &v1.DeleteRelationshipsRequest{
+			RelationshipFilter:            filter,
+			OptionalLimit:                 deleteBatchSize,
+			OptionalAllowPartialDeletions: true,
+		})
+		if err != nil {
+			return spiceDbErrorToDomainError(err)
+		}
+
+		if resp.DeletionProgress != v1.DeleteRelationshipsResponse_DELETION_PROGRESS_PARTIAL {
+			return nil
+		}
+	}
+}
+
+// SetSeatReclamation enables or disables the automatic unassignment of seats held by disabled subjects for a license
+func (s *SpiceDbAccessRepository) SetSeatReclamation(ctx context.Context, orgID string, serviceID string, enabled bool) error {
+	policy, licenseObj := createSubjectObjectTuple(ReclaimPolicyType, ReclaimDisabledID, LicenseObjectType, fmt.Sprintf("%s/%s", orgID, serviceID))
+
+	operation := v1.RelationshipUpdate_OPERATION_DELETE
+	if enabled {
+		operation = v1.RelationshipUpdate_OPERATION_TOUCH
+	}
+
+	_, err := s.client.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{{
+			Operation: operation,
+			Relationship: &v1.Relationship{
+				Resource: licenseObj,
+				Relation: LicenseReclaimStr,
+				Subject:  policy,
+			},
+		}},
+		// The policy must not outlive a license revoked concurrently
+		OptionalPreconditions: []*v1.Precondition{
+			createLicenseRelationPrecondition(licenseObj, OrgType, &v1.SubjectReference{Object: &v1.ObjectReference{ObjectType: OrgType, ObjectId: orgID}}),
+		},
+	})
+
+	if err != nil {
+		err = spiceDbErrorToDomainError(err)
+		if errors.Is(err, domain.ErrConflict) {
+			return domain.ErrLicenseNotFound
+		}
+
+		glog.Errorf("Error changing seat reclamation of license %s for org %s.\nInternal error: %v", serviceID, orgID, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetLicensesReclaimingSeats retrieves the licenses of all organizations that have seat reclamation enabled, ordered by organization and service ID
+func (s *SpiceDbAccessRepository) GetLicensesReclaimingSeats(ctx context.Context) ([]*domain.License, error) {
+	rels, err := s.readRelationships(ctx, &v1.RelationshipFilter{
+		ResourceType:     LicenseObjectType,
+		OptionalRelation: LicenseReclaimStr,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	licenseIDs := make([]string, 0, len(rels))
+	for _, rel := range rels {
+		licenseIDs = append(licenseIDs, rel.Resource.ObjectId)
+	}
+	sort.Strings(licenseIDs)
+
+	licenses := make([]*domain.License, 0, len(licenseIDs))
+	for _, licenseID := range licenseIDs {
+		orgID, serviceID, _ := strings.Cut(licenseID, "/")
+		license, err := s.GetLicense(ctx, orgID, serviceID)
+		if err != nil {
+			return nil, err
+		}
+
+		licenses = append(licenses, license)
+	}
+
+	return licenses, nil
+}
+
+// AddLicenseAdmin allows the given subject to manage the license of an organization for a service
+func (s *SpiceDbAccessRepository) AddLicenseAdmin(ctx context.Context, orgID string, serviceID string, subjectID domain.SubjectID) error {
+	return s.writeLicenseAdmin(ctx, v1.RelationshipUpdate_OPERATION_TOUCH, orgID, serviceID, subjectID)
+}
+
+// RemoveLicenseAdmin revokes the permission of the given subject to manage the license of an organization for a service
+func (s *SpiceDbAccessRepository) RemoveLicenseAdmin(ctx context.Context, orgID string, serviceID string, subjectID domain.SubjectID) error {
+	return s.writeLicenseAdmin(ctx, v1.RelationshipUpdate_OPERATION_DELETE, orgID, serviceID, subjectID)
+}
+
+func (s *SpiceDbAccessRepository) writeLicenseAdmin(ctx context.Context, operation v1.RelationshipUpdate_Operation, orgID string, serviceID string, subjectID domain.SubjectID) error {
+	subject, object := createSubjectObjectTuple(SubjectType, string(subjectID), LicenseObjectType, fmt.Sprintf("%s/%s", orgID, serviceID))
+
+	var preconditions []*v1.Precondition
+	if operation == v1.RelationshipUpdate_OPERATION_TOUCH {
+		// The admin must not outlive a license revoked concurrently
+		preconditions = append(preconditions, &v1.Precondition{
+			Operation: v1.Precondition_OPERATION_MUST_MATCH,
+			Filter: &v1.RelationshipFilter{
+				ResourceType:       object.ObjectType,
+				OptionalResourceId: object.ObjectId,
+				OptionalRelation:   LicenseVersionStr,
+			},
+		})
+	}
+
+	_, err := s.client.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
+		Updates: []*v1.RelationshipUpdate{{
+			Operation: operation,
+			Relationship: &v1.Relationship{
+				Resource: object,
+				Relation: LicenseAdminStr,
+				Subject:  subject,
+			},
+		}},
+		OptionalPreconditions: preconditions,
+	})
+
+	if err != nil {
+		err = spiceDbErrorToDomainError(err)
+		if errors.Is(err, domain.ErrConflict) {
+			return domain.ErrLicenseNotFound
+		}
+
+		glog.Errorf("Error changing license admin %s of license %s for org %s.\nInternal error: %v", subjectID, serviceID, orgID, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *SpiceDbAccessRepository) readRelationships(ctx context.Context, filter *v1.RelationshipFilter) ([]*v1.Relationship, error) {
+	return s.readRelationshipsPage(ctx, filter, 0)
+}
+
+// readRelationshipsPage reads the first limit relationships matching the filter, all of them if limit is 0. SpiceDB returns limited reads ordered by resource ID.
+func (s *SpiceDbAccessRepository) readRelationshipsPage(ctx context.Context, filter *v1.RelationshipFilter, limit uint32) ([]*v1.Relationship, error) {
+	return s.readRelationshipsAt(ctx, filter, limit, &v1.Consistency{Requirement: &v1.Consistency_FullyConsistent{FullyConsistent: true}})
+}
+
+// readRelationshipsAt reads like readRelationshipsPage with the given consistency
+func (s *SpiceDbAccessRepository) readRelationshipsAt(ctx context.Context, filter *v1.RelationshipFilter, limit uint32, consistency *v1.Consistency) ([]*v1.Relationship, error) {
+	resp, err := s.client.ReadRelationships(ctx, &v1.ReadRelationshipsRequest{
+		Consistency:        consistency,
+		RelationshipFilter: filter,
+		OptionalLimit:      limit,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var rels []*v1.Relationship
+	for {
+		next, err := resp.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		rels = append(rels, next.Relationship)
+	}
+
+	return rels, nil
+}
+
+func createMaxSubjectFromLicense(lic *domain.License) *v1.SubjectReference {
+	return &v1.SubjectReference{
+		Object: &v1.ObjectReference{
+			ObjectType: "max",
+			ObjectId:   strconv.Itoa(lic.MaxSeats),
+		},
+	}
+}
+
+func createLicenseRelationPrecondition(licenseObj *v1.ObjectReference, relation string, subj *v1.SubjectReference) *v1.Precondition {
+	return &v1.Precondition{
+		Operation: v1.Precondition_OPERATION_MUST_MATCH,
+		Filter: &v1.RelationshipFilter{
+			ResourceType:       licenseObj.ObjectType,
+			OptionalResourceId: licenseObj.ObjectId,
+			OptionalRelation:   relation,
+			OptionalSubjectFilter: &v1.SubjectFilter{
+				SubjectType:       subj.Object.ObjectType,
+				OptionalSubjectId: subj.Object.ObjectId,
+			},
+		},
+	}
 }
 
 // AddSubject stores a subject associated with an organization. If a subject is already found, it returns an error.
-func (s *SpiceDbAccessRepository) AddSubject(orgID string, subject domain.Subject) error {
+func (s *SpiceDbAccessRepository) AddSubject(ctx context.Context, orgID string, subject domain.Subject) error {
 	relationshipUpdates := make([]*v1.RelationshipUpdate, 0, 2)
 
 	orgResource := &v1.ObjectReference{
@@ -489,7 +1179,7 @@ func (s *SpiceDbAccessRepository) AddSubject(orgID string, subject domain.Subjec
 		})
 	}
 
-	_, err := s.client.WriteRelationships(s.ctx, &v1.WriteRelationshipsRequest{
+	_, err := s.client.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
 		Updates: relationshipUpdates,
 		OptionalPreconditions: []*v1.Precondition{{
 			Operation: v1.Precondition_OPERATION_MUST_NOT_MATCH,
@@ -515,7 +1205,7 @@ func (s *SpiceDbAccessRepository) AddSubject(orgID string, subject domain.Subjec
 }
 
 // UpsertSubject stores a subject associated with an organization. If a subject is found, it gets updated. If it is not found, it gets created.
-func (s *SpiceDbAccessRepository) UpsertSubject(orgID string, subject domain.Subject) error {
+func (s *SpiceDbAccessRepository) UpsertSubject(ctx context.Context, orgID string, subject domain.Subject) error {
 	relationshipUpdates := make([]*v1.RelationshipUpdate, 0, 2)
 
 	orgResource := &v1.ObjectReference{
@@ -560,7 +1250,7 @@ func (s *SpiceDbAccessRepository) UpsertSubject(orgID string, subject domain.Sub
 		},
 	})
 
-	_, err := s.client.WriteRelationships(s.ctx, &v1.WriteRelationshipsRequest{
+	_, err := s.client.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
 		Updates: relationshipUpdates,
 	})
 
@@ -569,10 +1259,70 @@ func (s *SpiceDbAccessRepository) UpsertSubject(orgID string, subject domain.Sub
 	return err
 }
 
+// RemoveSubject removes the membership of a subject in an organization, including its disabled and admin status. Removing a subject that is no member is a no-op.
+func (s *SpiceDbAccessRepository) RemoveSubject(ctx context.Context, orgID string, subjectID domain.SubjectID) error {
+	userSubject, orgResource := createSubjectObjectTuple(SubjectType, string(subjectID), OrgType, orgID)
+
+	relationshipUpdates := make([]*v1.RelationshipUpdate, 0, 3)
+	for _, relation := range []string{"member", "disabled", "admin"} {
+		relationshipUpdates = append(relationshipUpdates, &v1.RelationshipUpdate{
+			Operation: v1.RelationshipUpdate_OPERATION_DELETE,
+			Relationship: &v1.Relationship{
+				Resource: orgResource,
+				Relation: relation,
+				Subject:  userSubject,
+			},
+		})
+	}
+
+	_, err := s.client.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{
+		Updates: relationshipUpdates,
+	})
+	if err != nil {
+		glog.Errorf("Error removing subject %s from org %s: %v", subjectID, orgID, err)
+		return spiceDbErrorToDomainError(err)
+	}
+
+	return nil
+}
+
+// GetSubjectOrgs retrieves the IDs of the organizations the subject is a member of, ordered by ID
+func (s *SpiceDbAccessRepository) GetSubjectOrgs(ctx context.Context, subjectID domain.SubjectID) ([]string, error) {
+	rels, err := s.readRelationships(ctx, &v1.RelationshipFilter{
+		ResourceType:     OrgType,
+		OptionalRelation: "member",
+		OptionalSubjectFilter: &v1.SubjectFilter{
+			SubjectType:       SubjectType,
+			OptionalSubjectId: string(subjectID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orgIDs := make([]string, 0, len(rels))
+	for _, rel := range rels {
+		orgIDs = append(orgIDs, rel.Resource.ObjectId)
+	}
+	sort.Strings(orgIDs)
+
+	return orgIDs, nil
+}
+
+// Ping checks that SpiceDB answers requests by reading the schema
+func (s *SpiceDbAccessRepository) Ping(ctx context.Context) error {
+	_, err := s.client.ReadSchema(ctx, &v1.ReadSchemaRequest{})
+	return err
+}
+
 // NewConnection creates a new connection to an underlying SpiceDB store and saves it to the package variable conn
 func (s *SpiceDbAccessRepository) NewConnection(spiceDbEndpoint string, token string, isBlocking, useTLS bool) error {
 
-	var opts []grpc.DialOption
+	opts := []grpc.DialOption{
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(metrics.SpiceDbUnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(metrics.SpiceDbStreamClientInterceptor()),
+	}
 
 	if isBlocking {
 		opts = append(opts, grpc.WithBlock())
@@ -597,7 +1347,6 @@ func (s *SpiceDbAccessRepository) NewConnection(spiceDbEndpoint string, token st
 	}
 
 	s.client = client
-	s.ctx = context.Background()
 	return nil
 }
 
@@ -2,6 +2,10 @@ package authzed
 
 import (
 	"authz/domain"
+	"authz/infrastructure/repository/authzed/migrations"
+	"authz/infrastructure/repository/contracttest"
+	"authz/infrastructure/snapshot"
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -44,7 +48,7 @@ func TestCheckAccess(t *testing.T) {
 	}
 
 	for _, testcase := range cases {
-		actual, err := client.CheckAccess(testcase.sub, testcase.operation, testcase.resource)
+		actual, err := client.CheckAccess(context.Background(), testcase.sub, testcase.operation, testcase.resource)
 		assert.NoError(t, err, fmt.Sprintf("Error in case (subject: %s, operation: %s, resource: [%s, %s])", testcase.sub, testcase.operation, testcase.resource.Type, testcase.resource.ID))
 		assert.Equal(t, testcase.expected, actual, "Unexpected result for case (subject: %s, operation: %s, resource: [%s, %s])", testcase.sub, testcase.operation, testcase.resource.Type, testcase.resource.ID)
 	}
@@ -56,7 +60,7 @@ func TestGetLicense(t *testing.T) {
 	client, _, err := container.CreateClient()
 	assert.NoError(t, err)
 
-	lic, err := client.GetLicense("o1", "smarts")
+	lic, err := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, err)
 
 	assert.Equal(t, "o1", lic.OrgID)
@@ -71,7 +75,7 @@ func TestGetAssignable(t *testing.T) {
 	client, _, err := container.CreateClient()
 	assert.NoError(t, err)
 
-	assignable, err := client.GetAssignable("o1", "smarts")
+	assignable, err := client.GetAssignable(context.Background(), "o1", "smarts")
 	assert.NoError(t, err)
 	initialAssignableUsers := []domain.SubjectID{"u2", "u5", "u6", "u7", "u8", "u9", "u10", "u11", "u12", "u13", "u14", "u15", "u16", "u17", "u18", "u19", "u20"}
 
@@ -84,7 +88,7 @@ func TestGetAssigned(t *testing.T) {
 	client, _, err := container.CreateClient()
 	assert.NoError(t, err)
 
-	assigned, err := client.GetAssigned("o1", "smarts")
+	assigned, err := client.GetAssigned(context.Background(), "o1", "smarts")
 	assert.NoError(t, err)
 
 	assert.ElementsMatch(t, []domain.SubjectID{"u1", "u3"}, assigned)
@@ -101,16 +105,16 @@ func TestAssignBatch(t *testing.T) {
 		"u6", "u7",
 	}
 
-	oldLic, e1 := client.GetLicense("o1", "smarts")
+	oldLic, e1 := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, e1)
 	assert.Equal(t, 2, oldLic.InUse)
 
 	// when
-	err = client.ModifySeats(subs, []domain.SubjectID{}, oldLic, "o1", domain.Service{ID: "smarts"})
+	err = client.ModifySeats(context.Background(), subs, []domain.SubjectID{}, oldLic, "o1", domain.Service{ID: "smarts"})
 
 	// then
 	assert.NoError(t, err)
-	newLic, e2 := client.GetLicense("o1", "smarts")
+	newLic, e2 := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, e2)
 	assert.Equal(t, oldLic.InUse+len(subs), newLic.InUse)
 }
@@ -125,17 +129,17 @@ func TestFailAssignBatchIfOneDisabled(t *testing.T) {
 	subs := []domain.SubjectID{
 		"u4", "u101",
 	}
-	oldLic, e1 := client.GetLicense("o1", "smarts")
+	oldLic, e1 := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, e1)
 	assert.Equal(t, 2, oldLic.InUse) // u1, u3
 
 	// when
-	err = client.ModifySeats(subs, []domain.SubjectID{}, oldLic, "o1", domain.Service{ID: "smarts"})
+	err = client.ModifySeats(context.Background(), subs, []domain.SubjectID{}, oldLic, "o1", domain.Service{ID: "smarts"})
 
 	// then
 	assert.Error(t, err)
 
-	expectedSameLicense, e2 := client.GetLicense("o1", "smarts")
+	expectedSameLicense, e2 := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, e2)
 	assert.Equal(t, 2, expectedSameLicense.InUse) // still u1, u3, so if error in batch nothing gets applied.
 }
@@ -151,16 +155,16 @@ func TestUnassignBatch(t *testing.T) {
 		"u1", "u3",
 	}
 
-	oldLic, e1 := client.GetLicense("o1", "smarts")
+	oldLic, e1 := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, e1)
 	assert.Equal(t, 2, oldLic.InUse) //u1, u3
 
 	// when
-	err = client.ModifySeats([]domain.SubjectID{}, subs, oldLic, "o1", domain.Service{ID: "smarts"})
+	err = client.ModifySeats(context.Background(), []domain.SubjectID{}, subs, oldLic, "o1", domain.Service{ID: "smarts"})
 
 	// then
 	assert.NoError(t, err)
-	newLic, e2 := client.GetLicense("o1", "smarts")
+	newLic, e2 := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, e2)
 	assert.Equal(t, oldLic.InUse-len(subs), newLic.InUse)
 }
@@ -171,21 +175,21 @@ func TestAssignUnassign(t *testing.T) {
 	client, _, err := container.CreateClient()
 	assert.NoError(t, err)
 
-	oldLic, err := client.GetLicense("o1", "smarts")
+	oldLic, err := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, err)
 
-	err = client.ModifySeats([]domain.SubjectID{"u2"}, []domain.SubjectID{}, oldLic, "o1", domain.Service{ID: "smarts"})
+	err = client.ModifySeats(context.Background(), []domain.SubjectID{"u2"}, []domain.SubjectID{}, oldLic, "o1", domain.Service{ID: "smarts"})
 	assert.NoError(t, err)
 
-	lic, err := client.GetLicense("o1", "smarts")
+	lic, err := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, err)
 
 	assert.Equal(t, 3, lic.InUse) //u1, u2, u3
 
-	err = client.ModifySeats([]domain.SubjectID{}, []domain.SubjectID{"u2"}, lic, "o1", domain.Service{ID: "smarts"})
+	err = client.ModifySeats(context.Background(), []domain.SubjectID{}, []domain.SubjectID{"u2"}, lic, "o1", domain.Service{ID: "smarts"})
 	assert.NoError(t, err)
 
-	lic, err = client.GetLicense("o1", "smarts")
+	lic, err = client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, err)
 
 	assert.Equal(t, 2, lic.InUse) //u1, u3
@@ -197,13 +201,13 @@ func TestUnassignNotAssigned(t *testing.T) {
 	client, _, err := container.CreateClient()
 	assert.NoError(t, err)
 
-	licBefore, err := client.GetLicense("o1", "smarts")
+	licBefore, err := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, err)
 
-	err = client.ModifySeats([]domain.SubjectID{}, []domain.SubjectID{"not_assigned"}, licBefore, "o1", domain.Service{ID: "smarts"})
+	err = client.ModifySeats(context.Background(), []domain.SubjectID{}, []domain.SubjectID{"not_assigned"}, licBefore, "o1", domain.Service{ID: "smarts"})
 	assert.Error(t, err)
 
-	licAfter, err := client.GetLicense("o1", "smarts")
+	licAfter, err := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, err)
 
 	assert.Equal(t, licBefore.InUse, licAfter.InUse)
@@ -215,13 +219,13 @@ func TestAssignAlreadyAssigned(t *testing.T) {
 	client, _, err := container.CreateClient()
 	assert.NoError(t, err)
 
-	licBefore, err := client.GetLicense("o1", "smarts")
+	licBefore, err := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, err)
 
-	err = client.ModifySeats([]domain.SubjectID{"u1"}, []domain.SubjectID{}, licBefore, "o1", domain.Service{ID: "smarts"})
+	err = client.ModifySeats(context.Background(), []domain.SubjectID{"u1"}, []domain.SubjectID{}, licBefore, "o1", domain.Service{ID: "smarts"})
 	assert.Error(t, err)
 
-	licAfter, err := client.GetLicense("o1", "smarts")
+	licAfter, err := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, err)
 
 	assert.Equal(t, licBefore.InUse, licAfter.InUse)
@@ -233,10 +237,10 @@ func TestFailAssignForDisabled(t *testing.T) {
 	client, _, err := container.CreateClient()
 	assert.NoError(t, err)
 
-	licBefore, err := client.GetLicense("o1", "smarts")
+	licBefore, err := client.GetLicense(context.Background(), "o1", "smarts")
 	assert.NoError(t, err)
 
-	err = client.ModifySeats([]domain.SubjectID{"u4"}, []domain.SubjectID{}, licBefore, "o1", domain.Service{ID: "smarts"})
+	err = client.ModifySeats(context.Background(), []domain.SubjectID{"u4"}, []domain.SubjectID{}, licBefore, "o1", domain.Service{ID: "smarts"})
 
 	assert.Error(t, err)
 }
@@ -246,7 +250,7 @@ func TestHasAnyLicenseReturnsTrueForOrgWithLicenseWithoutUsers(t *testing.T) {
 	repository, _, err := container.CreateClient()
 	assert.NoError(t, err)
 
-	result, err := repository.HasAnyLicense("oNoUsers")
+	result, err := repository.HasAnyLicense(context.Background(), "oNoUsers")
 	assert.NoError(t, err)
 	assert.True(t, result)
 }
@@ -256,7 +260,7 @@ func TestHasAnyLicenseReturnsTrueForOrgWithLicenseWithUsers(t *testing.T) {
 	repository, _, err := container.CreateClient()
 	assert.NoError(t, err)
 
-	result, err := repository.HasAnyLicense("o1")
+	result, err := repository.HasAnyLicense(context.Background(), "o1")
 	assert.NoError(t, err)
 	assert.True(t, result)
 }
@@ -266,7 +270,7 @@ func TestHasAnyLicenseReturnsFalseForUnknownOrgID(t *testing.T) {
 	repository, _, err := container.CreateClient()
 	assert.NoError(t, err)
 
-	result, err := repository.HasAnyLicense("unknownOrgId")
+	result, err := repository.HasAnyLicense(context.Background(), "unknownOrgId")
 	assert.NoError(t, err)
 	assert.False(t, result)
 }
@@ -276,11 +280,107 @@ func TestHasAnyLicenseReturnsFalseForOrgWithoutLicense(t *testing.T) {
 	repository, _, err := container.CreateClient()
 	assert.NoError(t, err)
 
-	result, err := repository.HasAnyLicense("o2")
+	result, err := repository.HasAnyLicense(context.Background(), "o2")
 	assert.NoError(t, err)
 	assert.False(t, result)
 }
 
+func TestBootstrapSchemaIsLatestVersion(t *testing.T) {
+	t.Parallel()
+	repository, _, err := container.CreateClient()
+	assert.NoError(t, err)
+
+	status, err := migrations.NewMigrator(repository).Status(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, migrations.Latest().Number, status.Version)
+	assert.Empty(t, status.Changes)
+	assert.Empty(t, status.Pending)
+}
+
+func TestWrittenSchemaIsReadBack(t *testing.T) {
+	t.Parallel()
+	repository, _, err := container.CreateClient()
+	assert.NoError(t, err)
+
+	err = repository.WriteSchema(context.Background(), migrations.Latest().Schema)
+	assert.NoError(t, err)
+
+	schema, err := repository.ReadSchema(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, migrations.Diff(migrations.ParseSchema(schema), migrations.ParseSchema(migrations.Latest().Schema)))
+}
+
+func TestSnapshotImportOfExportChangesNothing(t *testing.T) {
+	t.Parallel()
+	repository, _, err := container.CreateClient()
+	assert.NoError(t, err)
+
+	exported, err := snapshot.Export(context.Background(), repository)
+	assert.NoError(t, err)
+	assert.Contains(t, exported.Relationships, "license_seats:o1/smarts#assigned@user:u1")
+
+	result, err := snapshot.Import(context.Background(), repository, exported)
+	assert.NoError(t, err)
+	assert.Equal(t, snapshot.ImportResult{}, result)
+}
+
+func TestSnapshotImportReplacesLicense(t *testing.T) {
+	t.Parallel()
+	repository, _, err := container.CreateClient()
+	assert.NoError(t, err)
+
+	result, err := snapshot.Import(context.Background(), repository, snapshot.Snapshot{Version: snapshot.FormatVersion, Relationships: `
+license:o1/smarts#org@org:o1
+license:o1/smarts#max@max:20
+license:o1/smarts#seats@license_seats:o1/smarts
+license:o1/smarts#version@version:restored/1
+license_seats:o1/smarts#assigned@user:u1`})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Created)
+
+	license, err := repository.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, 20, license.MaxSeats)
+	assert.Equal(t, 1, license.InUse)
+	assigned, err := repository.GetAssigned(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SubjectID{"u1"}, assigned)
+}
+
+func TestRevokeLicenseWithMoreSeatsThanFitIntoOneWrite(t *testing.T) {
+	t.Parallel()
+	repository, _, err := container.CreateClient()
+	assert.NoError(t, err)
+
+	err = repository.ApplyLicense(context.Background(), &domain.License{OrgID: "o9", ServiceID: "smarts", MaxSeats: 1200, Version: "l"})
+	assert.NoError(t, err)
+	var seats []snapshot.Relationship
+	for i := 0; i < 1200; i++ {
+		user := fmt.Sprintf("u%d", i)
+		seats = append(seats,
+			snapshot.Relationship{ResourceType: "license_seats", ResourceID: "o9/smarts", Relation: "assigned", SubjectType: "user", SubjectID: user},
+			snapshot.Relationship{ResourceType: "seat", ResourceID: "o9/smarts/" + user, Relation: "assigned_at", SubjectType: "timestamp", SubjectID: "1700000000"})
+	}
+	assert.NoError(t, repository.UpdateRelationships(context.Background(), seats, nil))
+
+	err = repository.RevokeLicense(context.Background(), "o9", "smarts")
+	assert.NoError(t, err)
+
+	_, err = repository.GetLicense(context.Background(), "o9", "smarts")
+	assert.ErrorIs(t, err, domain.ErrLicenseNotFound)
+	assigned, err := repository.GetAssigned(context.Background(), "o9", "smarts")
+	assert.NoError(t, err)
+	assert.Empty(t, assigned)
+	remaining, _, err := repository.ReadRelationships(context.Background(), []string{"seat"})
+	assert.NoError(t, err)
+	for _, rel := range remaining {
+		assert.NotContains(t, rel.ResourceID, "o9/smarts/")
+	}
+
+	err = repository.RevokeLicense(context.Background(), "o9", "smarts")
+	assert.ErrorIs(t, err, domain.ErrLicenseNotFound)
+}
+
 // Six scenarios:
 // Scenario 1: If previously enabled, delete nonexistent tombstone (no change)
 // Scenario 2: if previously disabled, delete tombstone, now enabled
@@ -343,7 +443,7 @@ func TestUpsertUser(t *testing.T) {
 	assert.NoError(t, err)
 
 	for _, tt := range tests {
-		err = repository.UpsertSubject(tt.orgID, tt.subject)
+		err = repository.UpsertSubject(context.Background(), tt.orgID, tt.subject)
 		assert.NoError(t, err)
 
 		//Assert relationship exists user -member-> org
@@ -358,3 +458,19 @@ func TestUpsertUser(t *testing.T) {
 		}
 	}
 }
+
+func TestSpiceDbAccessRepositoryConformance(t *testing.T) {
+	repository, _, err := container.CreateClient()
+	assert.NoError(t, err)
+	repository.EnableOutbox()
+
+	contracttest.RunSeatLicenseRepositoryTests(t, func(t *testing.T) contracttest.Harness {
+		return contracttest.Harness{
+			Access:             repository,
+			Seats:              repository,
+			Orgs:               repository,
+			Outbox:             repository,
+			WaitForConsistency: container.WaitForQuantizationInterval,
+		}
+	})
+}
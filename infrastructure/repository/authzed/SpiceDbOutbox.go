@@ -0,0 +1,201 @@
+package authzed
+
+import (
+	"authz/domain"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"github.com/golang/glog"
+)
+
+const (
+	// OutboxEventObjectType - pending license event, IDs are <unix nanos>_<index>_<random>
+	OutboxEventObjectType = "outbox_event"
+	// OutboxPayloadObjectType - license event payload, IDs are the base64 encoded JSON of the event
+	OutboxPayloadObjectType = "outbox_payload"
+	// OutboxPayloadStr - outbox event payload relation
+	OutboxPayloadStr = "payload"
+)
+
+// outboxPayload is the serialized form of a domain.LicenseEvent stored in the outbox. The keys are short, since SpiceDB limits the length of object IDs.
+type outboxPayload struct {
+	Type           string `json:"t"`
+	OrgID          string `json:"o"`
+	ServiceID      string `json:"s"`
+	SubjectID      string `json:"u,omitempty"`
+	SeatsTotal     int    `json:"m"`
+	SeatsAvailable int    `json:"a"`
+	PreviousSeats  int    `json:"p,omitempty"`
+}
+
+// EnableOutbox makes ModifySeats, ApplyLicense, UpdateLicense and RevokeLicense record license events in the outbox within the same write as their changes. The content of each event is encoded in its relationship, so that an event is recorded if and only if the change is.
+func (s *SpiceDbAccessRepository) EnableOutbox() {
+	s.outboxEnabled = true
+}
+
+// GetPendingEvents retrieves up to limit events that were not marked as sent yet, oldest first. Events whose payload cannot be decoded are logged and marked as sent, since they can never be published.
+func (s *SpiceDbAccessRepository) GetPendingEvents(ctx context.Context, limit int) ([]domain.OutboxEvent, error) {
+	if !s.outboxEnabled || limit <= 0 {
+		return []domain.OutboxEvent{}, nil
+	}
+
+	rels, err := s.readRelationshipsPage(ctx, &v1.RelationshipFilter{ResourceType: OutboxEventObjectType, OptionalRelation: OutboxPayloadStr}, uint32(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(rels, func(i, j int) bool {
+		return rels[i].Resource.ObjectId < rels[j].Resource.ObjectId
+	})
+
+	evts := make([]domain.OutboxEvent, 0, len(rels))
+	var undecodable []string
+	for _, rel := range rels {
+		evt, err := decodeOutboxPayload(rel.Subject.Object.ObjectId)
+		if err != nil {
+			glog.Errorf("Skipping outbox event %s with invalid payload %q: %v", rel.Resource.ObjectId, rel.Subject.Object.ObjectId, err)
+			undecodable = append(undecodable, outboxEventID(rel))
+			continue
+		}
+		evts = append(evts, domain.OutboxEvent{ID: outboxEventID(rel), LicenseEvent: evt})
+	}
+
+	if len(undecodable) > 0 {
+		// Failing to remove them only means they are skipped again by the next read
+		if err := s.MarkEventsSent(ctx, undecodable); err != nil {
+			glog.Errorf("Error removing %d outbox events with invalid payloads: %v", len(undecodable), err)
+		}
+	}
+
+	return evts, nil
+}
+
+// MarkEventsSent removes the events with the given IDs from the outbox, deleting up to deleteBatchSize events per write
+func (s *SpiceDbAccessRepository) MarkEventsSent(ctx context.Context, ids []string) error {
+	rels := make([]*v1.Relationship, 0, len(ids))
+	for _, id := range ids {
+		if rel, ok := parseOutboxEventID(id); ok {
+			rels = append(rels, rel)
+		}
+	}
+
+	for start := 0; start < len(rels); start += deleteBatchSize {
+		end := start + deleteBatchSize
+		if end > len(rels) {
+			end = len(rels)
+		}
+
+		updates := make([]*v1.RelationshipUpdate, 0, end-start)
+		for _, rel := range rels[start:end] {
+			updates = append(updates, &v1.RelationshipUpdate{Operation: v1.RelationshipUpdate_OPERATION_DELETE, Relationship: rel})
+		}
+
+		if _, err := s.client.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{Updates: updates}); err != nil {
+			glog.Errorf("Error marking %d outbox events as sent: %v", len(updates), err)
+			return spiceDbErrorToDomainError(err)
+		}
+	}
+
+	return nil
+}
+
+// addOutboxEvents appends the updates recording the given events to updates if the outbox is enabled
+func (s *SpiceDbAccessRepository) addOutboxEvents(updates []*v1.RelationshipUpdate, evts []domain.LicenseEvent) ([]*v1.RelationshipUpdate, error) {
+	if !s.outboxEnabled {
+		return updates, nil
+	}
+
+	now := time.Now().UnixNano()
+	for i, evt := range evts {
+		payload, err := encodeOutboxPayload(evt)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, &v1.RelationshipUpdate{
+			Operation:    v1.RelationshipUpdate_OPERATION_CREATE,
+			Relationship: createOutboxEventRelationship(fmt.Sprintf("%019d_%04d_%s", now, i, randomSuffix()), payload),
+		})
+	}
+
+	return updates, nil
+}
+
+// outboxEventID identifies a pending event by both its object ID and payload, so that MarkEventsSent deletes its relationship without reading it again. It sorts like the object ID, which has a fixed length.
+func outboxEventID(rel *v1.Relationship) string {
+	return rel.Resource.ObjectId + ":" + rel.Subject.Object.ObjectId
+}
+
+// parseOutboxEventID returns the relationship of the event with the given ID, false if the ID was not returned by outboxEventID
+func parseOutboxEventID(id string) (*v1.Relationship, bool) {
+	eventID, payload, ok := strings.Cut(id, ":")
+	if !ok || eventID == "" || payload == "" {
+		return nil, false
+	}
+
+	return createOutboxEventRelationship(eventID, payload), true
+}
+
+func createOutboxEventRelationship(id string, payload string) *v1.Relationship {
+	return &v1.Relationship{
+		Resource: &v1.ObjectReference{ObjectType: OutboxEventObjectType, ObjectId: id},
+		Relation: OutboxPayloadStr,
+		Subject:  &v1.SubjectReference{Object: &v1.ObjectReference{ObjectType: OutboxPayloadObjectType, ObjectId: payload}},
+	}
+}
+
+func encodeOutboxPayload(evt domain.LicenseEvent) (string, error) {
+	data, err := json.Marshal(outboxPayload{
+		Type:           string(evt.Type),
+		OrgID:          evt.OrgID,
+		ServiceID:      evt.ServiceID,
+		SubjectID:      string(evt.SubjectID),
+		SeatsTotal:     evt.SeatsTotal,
+		SeatsAvailable: evt.SeatsAvailable,
+		PreviousSeats:  evt.PreviousSeatsTotal,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Standard base64 only uses characters allowed in SpiceDB object IDs
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+func decodeOutboxPayload(encoded string) (domain.LicenseEvent, error) {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return domain.LicenseEvent{}, err
+	}
+
+	var payload outboxPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return domain.LicenseEvent{}, err
+	}
+
+	return domain.LicenseEvent{
+		Type:               domain.LicenseEventType(payload.Type),
+		OrgID:              payload.OrgID,
+		ServiceID:          payload.ServiceID,
+		SubjectID:          domain.SubjectID(payload.SubjectID),
+		SeatsTotal:         payload.SeatsTotal,
+		SeatsAvailable:     payload.SeatsAvailable,
+		PreviousSeatsTotal: payload.PreviousSeats,
+	}, nil
+}
+
+func randomSuffix() string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
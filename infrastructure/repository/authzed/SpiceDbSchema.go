@@ -0,0 +1,64 @@
+package authzed
+
+import (
+	"authz/infrastructure/repository/authzed/migrations"
+	"context"
+	"sort"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// accessInTermVersion is the schema version whose access_in_term permission excludes the out_of_term relationships of licenses
+const accessInTermVersion = 13
+
+// ReadSchema returns the schema of the store, empty if no schema has been written yet
+func (s *SpiceDbAccessRepository) ReadSchema(ctx context.Context) (string, error) {
+	resp, err := s.client.ReadSchema(ctx, &v1.ReadSchemaRequest{})
+	if status.Code(err) == codes.NotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return resp.SchemaText, nil
+}
+
+// WriteSchema replaces the schema of the store. SpiceDB rejects removing relations that are still in use.
+func (s *SpiceDbAccessRepository) WriteSchema(ctx context.Context, schema string) error {
+	_, err := s.client.WriteSchema(ctx, &v1.WriteSchemaRequest{Schema: schema})
+	return err
+}
+
+// DeleteRelationshipsOfDefinition deletes all relationships whose resource is of the given definition, in batches
+func (s *SpiceDbAccessRepository) DeleteRelationshipsOfDefinition(ctx context.Context, definition string) error {
+	return s.deleteRelationships(ctx, &v1.RelationshipFilter{ResourceType: definition})
+}
+
+// BackfillRelationships writes the out_of_term relationships of the licenses with a term before the access_in_term permission is introduced, as licenses stored before only have their start and end. Other versions need no backfill.
+func (s *SpiceDbAccessRepository) BackfillRelationships(ctx context.Context, version migrations.Version) error {
+	if version.Number != accessInTermVersion {
+		return nil
+	}
+
+	licenses := make(map[string]bool)
+	for _, relation := range []string{LicenseStartStr, LicenseEndStr} {
+		rels, err := s.readRelationships(ctx, &v1.RelationshipFilter{ResourceType: LicenseObjectType, OptionalRelation: relation})
+		if err != nil {
+			return err
+		}
+		for _, rel := range rels {
+			licenses[rel.Resource.ObjectId] = true
+		}
+	}
+
+	licenseIDs := make([]string, 0, len(licenses))
+	for licenseID := range licenses {
+		licenseIDs = append(licenseIDs, licenseID)
+	}
+	sort.Strings(licenseIDs)
+
+	return s.updateOutOfTermRelationships(ctx, licenseIDs)
+}
@@ -0,0 +1,104 @@
+package authzed
+
+import (
+	"authz/infrastructure/snapshot"
+	"context"
+
+	v1 "github.com/authzed/authzed-go/proto/authzed/api/v1"
+)
+
+// snapshotWriteBatchSize limits the relationship updates per write, which SpiceDB limits to 1000 by default
+const snapshotWriteBatchSize = 500
+
+// ReadRelationships returns all relationships of the resource types as of a single revision, the ZedToken of a fully consistent check made first, and that token
+func (s *SpiceDbAccessRepository) ReadRelationships(ctx context.Context, resourceTypes []string) ([]snapshot.Relationship, string, error) {
+	// The check is answered at the latest revision, its ZedToken pins the reads of all types to that revision
+	check, err := s.client.CheckPermission(ctx, &v1.CheckPermissionRequest{
+		Consistency: &v1.Consistency{Requirement: &v1.Consistency_FullyConsistent{FullyConsistent: true}},
+		Resource:    &v1.ObjectReference{ObjectType: LicenseObjectType, ObjectId: "snapshot"},
+		Permission:  "manage_license",
+		Subject:     &v1.SubjectReference{Object: &v1.ObjectReference{ObjectType: SubjectType, ObjectId: "snapshot"}},
+	})
+	if err != nil {
+		return nil, "", spiceDbErrorToDomainError(err)
+	}
+	consistency := &v1.Consistency{Requirement: &v1.Consistency_AtExactSnapshot{AtExactSnapshot: check.CheckedAt}}
+
+	var result []snapshot.Relationship
+	for _, resourceType := range resourceTypes {
+		rels, err := s.readRelationshipsAt(ctx, &v1.RelationshipFilter{ResourceType: resourceType}, 0, consistency)
+		if err != nil {
+			return nil, "", spiceDbErrorToDomainError(err)
+		}
+
+		for _, rel := range rels {
+			if rel.Relation == LicenseOutOfTermStr {
+				continue // derived from the license term, its caveat cannot be represented in a snapshot
+			}
+			result = append(result, snapshot.Relationship{
+				ResourceType:    rel.Resource.ObjectType,
+				ResourceID:      rel.Resource.ObjectId,
+				Relation:        rel.Relation,
+				SubjectType:     rel.Subject.Object.ObjectType,
+				SubjectID:       rel.Subject.Object.ObjectId,
+				SubjectRelation: rel.Subject.OptionalRelation,
+			})
+		}
+	}
+
+	return result, check.CheckedAt.GetToken(), nil
+}
+
+// UpdateRelationships creates the relationships to touch unless they exist and deletes the relationships to remove. Large updates are written in several batches, so they are not atomic, but the updates of each license are written together unless they exceed a batch.
+// The out_of_term relationships of licenses whose term changed are derived again after each batch.
+func (s *SpiceDbAccessRepository) UpdateRelationships(ctx context.Context, touch []snapshot.Relationship, remove []snapshot.Relationship) error {
+	changes := snapshot.Changes{Touch: touch, Remove: remove}
+	for _, batch := range changes.Batches(snapshotWriteBatchSize) {
+		updates := make([]*v1.RelationshipUpdate, 0, len(batch.Touch)+len(batch.Remove))
+		for _, rel := range batch.Remove {
+			updates = append(updates, createSnapshotRelationshipUpdate(v1.RelationshipUpdate_OPERATION_DELETE, rel))
+		}
+		for _, rel := range batch.Touch {
+			updates = append(updates, createSnapshotRelationshipUpdate(v1.RelationshipUpdate_OPERATION_TOUCH, rel))
+		}
+
+		if _, err := s.client.WriteRelationships(ctx, &v1.WriteRelationshipsRequest{Updates: updates}); err != nil {
+			return spiceDbErrorToDomainError(err)
+		}
+
+		if err := s.updateOutOfTermRelationships(ctx, licensesWithChangedTerm(batch)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func createSnapshotRelationshipUpdate(operation v1.RelationshipUpdate_Operation, rel snapshot.Relationship) *v1.RelationshipUpdate {
+	return &v1.RelationshipUpdate{
+		Operation: operation,
+		Relationship: &v1.Relationship{
+			Resource: &v1.ObjectReference{ObjectType: rel.ResourceType, ObjectId: rel.ResourceID},
+			Relation: rel.Relation,
+			Subject: &v1.SubjectReference{
+				Object:           &v1.ObjectReference{ObjectType: rel.SubjectType, ObjectId: rel.SubjectID},
+				OptionalRelation: rel.SubjectRelation,
+			},
+		},
+	}
+}
+
+// licensesWithChangedTerm returns the IDs of the licenses whose start or end the changes touch or remove
+func licensesWithChangedTerm(changes snapshot.Changes) []string {
+	var licenseIDs []string
+	seen := make(map[string]bool)
+	for _, rels := range [][]snapshot.Relationship{changes.Touch, changes.Remove} {
+		for _, rel := range rels {
+			if rel.ResourceType == LicenseObjectType && (rel.Relation == LicenseStartStr || rel.Relation == LicenseEndStr) && !seen[rel.ResourceID] {
+				seen[rel.ResourceID] = true
+				licenseIDs = append(licenseIDs, rel.ResourceID)
+			}
+		}
+	}
+	return licenseIDs
+}
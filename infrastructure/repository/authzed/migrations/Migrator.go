@@ -0,0 +1,137 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrIncompatibleSchema - the schema of the store lacks or differs in elements the code needs and is no known version to migrate from
+var ErrIncompatibleSchema = errors.New("IncompatibleSchema")
+
+// ErrSchemaNotMigrated - the schema of the store is an older version the code cannot work with
+var ErrSchemaNotMigrated = errors.New("SchemaNotMigrated")
+
+// SchemaStore reads and writes the schema of a store
+type SchemaStore interface {
+	// ReadSchema returns the schema of the store, empty if it has none
+	ReadSchema(ctx context.Context) (string, error)
+	// WriteSchema replaces the schema of the store
+	WriteSchema(ctx context.Context, schema string) error
+	// DeleteRelationshipsOfDefinition deletes all relationships whose resource is of the given definition, so that it can be dropped from the schema
+	DeleteRelationshipsOfDefinition(ctx context.Context, definition string) error
+	// BackfillRelationships writes the relationships the permissions of a version need and derives from the existing ones, e.g. the caveated relationships a new permission excludes. It is called right before the schema of the version is written, so the previous version has to define the relations written. It does nothing for most versions.
+	BackfillRelationships(ctx context.Context, version Version) error
+}
+
+// Status compares the schema of a store to the versions of the schema
+type Status struct {
+	Version    int       // the version the store schema equals, 0 if the store has no schema or it equals no version
+	Pending    []Version // the migrations bringing the store schema to the latest version, oldest first
+	Changes    []Change  // the differences of the store schema to the latest version
+	Compatible bool      // false if the store schema lacks or differs in elements of the latest version and is no version to migrate from
+}
+
+// Deletes returns the definitions whose relationships are deleted when the pending version is applied. A store without schema gets the latest version right away, so it has none to delete.
+func (s Status) Deletes(version Version) []string {
+	if s.Version == 0 {
+		return nil
+	}
+	return version.Dropped
+}
+
+// Migrator brings the schema of a store to the latest version by applying the versions following the current one in order.
+// A store schema that equals no version is left as it is if it has all elements of the latest version, e.g. if it was migrated by newer code.
+type Migrator struct {
+	store SchemaStore
+}
+
+// NewMigrator creates a new Migrator for the schema of the given store
+func NewMigrator(store SchemaStore) *Migrator {
+	return &Migrator{store: store}
+}
+
+// Status reads the schema of the store and determines the migrations to apply
+func (m *Migrator) Status(ctx context.Context) (Status, error) {
+	text, err := m.store.ReadSchema(ctx)
+	if err != nil {
+		return Status{}, err
+	}
+
+	current := ParseSchema(text)
+	latest := Latest()
+	status := Status{Changes: Diff(current, ParseSchema(latest.Schema)), Compatible: true, Pending: []Version{}}
+
+	if len(current) == 0 {
+		// An empty store gets the latest version right away
+		status.Pending = append(status.Pending, latest)
+		return status, nil
+	}
+
+	for i := len(versions) - 1; i >= 0; i-- {
+		if len(Diff(current, ParseSchema(versions[i].Schema))) == 0 {
+			status.Version = versions[i].Number
+			status.Pending = append(status.Pending, versions[i+1:]...)
+			return status, nil
+		}
+	}
+
+	for _, change := range status.Changes {
+		if change.Kind != Removed {
+			status.Compatible = false
+		}
+	}
+
+	return status, nil
+}
+
+// Check fails with ErrIncompatibleSchema or ErrSchemaNotMigrated unless the schema of the store is compatible with the latest version without migrations
+func (m *Migrator) Check(ctx context.Context) error {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !status.Compatible {
+		return fmt.Errorf("%w: %s", ErrIncompatibleSchema, formatChanges(status.Changes))
+	}
+	if len(status.Pending) > 0 {
+		return fmt.Errorf("%w: store schema is at version %d, latest is %d", ErrSchemaNotMigrated, status.Version, Latest().Number)
+	}
+
+	return nil
+}
+
+// Migrate applies the pending migrations and returns them. It fails with ErrIncompatibleSchema without changing the store if the schema of the store cannot be migrated.
+// The relationships of definitions dropped by a migration are deleted and the relationships its permissions need are backfilled right before its schema is written, so a failed migration is completed by the next run.
+func (m *Migrator) Migrate(ctx context.Context) ([]Version, error) {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !status.Compatible {
+		return nil, fmt.Errorf("%w: %s", ErrIncompatibleSchema, formatChanges(status.Changes))
+	}
+
+	for i, version := range status.Pending {
+		for _, definition := range status.Deletes(version) {
+			if err := m.store.DeleteRelationshipsOfDefinition(ctx, definition); err != nil {
+				return status.Pending[:i], fmt.Errorf("migrating schema to version %s: deleting relationships of %s: %w", version, definition, err)
+			}
+		}
+
+		if status.Version != 0 {
+			// A store without schema has no relationships to derive others from
+			if err := m.store.BackfillRelationships(ctx, version); err != nil {
+				return status.Pending[:i], fmt.Errorf("migrating schema to version %s: backfilling relationships: %w", version, err)
+			}
+		}
+
+		if err := m.store.WriteSchema(ctx, version.Schema); err != nil {
+			return status.Pending[:i], fmt.Errorf("migrating schema to version %s: %w", version, err)
+		}
+	}
+
+	return status.Pending, nil
+}
@@ -0,0 +1,176 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeSchemaStore struct {
+	schema    string
+	writes    []string
+	deletes   []string
+	backfills []string // the versions backfilled, each with the schema of the store at that time
+	failWrite error
+}
+
+func (f *fakeSchemaStore) ReadSchema(_ context.Context) (string, error) {
+	return f.schema, nil
+}
+
+func (f *fakeSchemaStore) WriteSchema(_ context.Context, schema string) error {
+	if f.failWrite != nil {
+		return f.failWrite
+	}
+	f.schema = schema
+	f.writes = append(f.writes, schema)
+	return nil
+}
+
+func (f *fakeSchemaStore) DeleteRelationshipsOfDefinition(_ context.Context, definition string) error {
+	f.deletes = append(f.deletes, definition)
+	return nil
+}
+
+func (f *fakeSchemaStore) BackfillRelationships(_ context.Context, version Version) error {
+	f.backfills = append(f.backfills, version.String())
+	if f.schema != Versions()[version.Number-2].Schema {
+		return errors.New("backfilled after the schema was written")
+	}
+	return nil
+}
+
+func TestEmptyStoreIsMigratedToLatestVersion(t *testing.T) {
+	store := &fakeSchemaStore{}
+	m := NewMigrator(store)
+
+	applied, err := m.Migrate(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Version{Latest()}, applied)
+	assert.Equal(t, Latest().Schema, store.schema)
+	assert.NoError(t, m.Check(context.Background()))
+}
+
+func TestOlderVersionIsMigratedThroughAllFollowingVersions(t *testing.T) {
+	all := Versions()
+	store := &fakeSchemaStore{schema: all[1].Schema}
+	m := NewMigrator(store)
+
+	status, err := m.Status(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, status.Version)
+	assert.True(t, status.Compatible)
+	assert.Equal(t, all[2:], status.Pending)
+	assert.Contains(t, status.Changes, Change{Kind: Added, Element: "license#license_admin"})
+	assert.ErrorIs(t, m.Check(context.Background()), ErrSchemaNotMigrated)
+
+	applied, err := m.Migrate(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, all[2:], applied)
+	assert.Len(t, store.writes, len(all)-2)
+	assert.Equal(t, Latest().Schema, store.schema)
+	assert.NoError(t, m.Check(context.Background()))
+}
+
+func TestRelationshipsOfDroppedDefinitionsAreDeletedBeforeTheSchemaIsWritten(t *testing.T) {
+	all := Versions()
+	store := &fakeSchemaStore{schema: all[8].Schema}
+	m := NewMigrator(store)
+
+	status, err := m.Status(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 9, status.Version)
+	assert.Equal(t, []string{"webhook", "webhook_config"}, status.Deletes(all[9]))
+
+	assert.Equal(t, []string{"dead_letter", "dead_letter_payload"}, status.Deletes(all[10]))
+
+	_, err = m.Migrate(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"webhook", "webhook_config", "dead_letter", "dead_letter_payload"}, store.deletes)
+	assert.Equal(t, Latest().Schema, store.schema)
+}
+
+func TestEmptyStoreHasNoRelationshipsToDelete(t *testing.T) {
+	store := &fakeSchemaStore{}
+
+	_, err := NewMigrator(store).Migrate(context.Background())
+
+	assert.NoError(t, err)
+	assert.Empty(t, store.deletes)
+	assert.Empty(t, store.backfills)
+}
+
+func TestRelationshipsAreBackfilledBeforeTheSchemaOfEachVersionIsWritten(t *testing.T) {
+	all := Versions()
+	store := &fakeSchemaStore{schema: all[len(all)-3].Schema}
+
+	applied, err := NewMigrator(store).Migrate(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, all[len(all)-2:], applied)
+	assert.Equal(t, []string{all[len(all)-2].String(), all[len(all)-1].String()}, store.backfills)
+}
+
+func TestLatestVersionIsNotMigratedAgain(t *testing.T) {
+	store := &fakeSchemaStore{schema: "// as returned by SpiceDB\n" + Latest().Schema}
+	m := NewMigrator(store)
+
+	applied, err := m.Migrate(context.Background())
+
+	assert.NoError(t, err)
+	assert.Empty(t, applied)
+	assert.Empty(t, store.writes)
+	status, err := m.Status(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, Latest().Number, status.Version)
+	assert.Empty(t, status.Changes)
+}
+
+func TestStoreSchemaWithAdditionalElementsIsCompatible(t *testing.T) {
+	store := &fakeSchemaStore{schema: Latest().Schema + "\ndefinition newer_feature {}\n"}
+	m := NewMigrator(store)
+
+	status, err := m.Status(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, status.Compatible)
+	assert.Zero(t, status.Version)
+	assert.Empty(t, status.Pending)
+	assert.Equal(t, []Change{{Kind: Removed, Element: "newer_feature"}}, status.Changes)
+
+	assert.NoError(t, m.Check(context.Background()))
+	applied, err := m.Migrate(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, applied)
+	assert.Empty(t, store.writes)
+}
+
+func TestStoreSchemaLackingElementsOfNoVersionIsIncompatible(t *testing.T) {
+	store := &fakeSchemaStore{schema: "definition user {}\ndefinition license {\n    relation owner: user\n}\n"}
+	m := NewMigrator(store)
+
+	status, err := m.Status(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, status.Compatible)
+
+	assert.ErrorIs(t, m.Check(context.Background()), ErrIncompatibleSchema)
+	applied, err := m.Migrate(context.Background())
+	assert.ErrorIs(t, err, ErrIncompatibleSchema)
+	assert.Contains(t, err.Error(), "+ license#org")
+	assert.Empty(t, applied)
+	assert.Empty(t, store.writes)
+}
+
+func TestFailedMigrationReportsAppliedVersions(t *testing.T) {
+	all := Versions()
+	store := &fakeSchemaStore{schema: all[len(all)-2].Schema, failWrite: errors.New("relations in use")}
+
+	applied, err := NewMigrator(store).Migrate(context.Background())
+
+	assert.ErrorContains(t, err, Latest().String())
+	assert.Empty(t, applied)
+}
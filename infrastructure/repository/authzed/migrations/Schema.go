@@ -0,0 +1,110 @@
+package migrations
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	comments    = regexp.MustCompile(`(?s)/\*.*?\*/|//[^\n]*`)
+	definitions = regexp.MustCompile(`(?s)definition\s+([\w/]+)\s*\{(.*?)\}`)
+	members     = regexp.MustCompile(`(relation|permission)\s+(\w+)\s*[:=]`)
+	whitespace  = regexp.MustCompile(`\s+`)
+)
+
+// Schema is the parsed form of a SpiceDB schema: its definitions by name, each with its relations and permissions by name
+type Schema map[string]map[string]string
+
+// ChangeKind tells how an element of a schema changed
+type ChangeKind string
+
+const (
+	// Added - the element only exists in the schema changed to
+	Added ChangeKind = "+"
+	// Removed - the element only exists in the schema changed from
+	Removed ChangeKind = "-"
+	// Modified - the type of the relation or the expression of the permission changed
+	Modified ChangeKind = "~"
+)
+
+// Change is a difference between two schemas
+type Change struct {
+	Kind    ChangeKind
+	Element string // a definition, e.g. "license", or one of its relations or permissions, e.g. "license#start"
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s %s", c.Kind, c.Element)
+}
+
+// ParseSchema parses the definitions of a schema in the SpiceDB schema language. Formatting and comments are ignored, so schemas read from SpiceDB compare equal to the files they were written from.
+func ParseSchema(text string) Schema {
+	schema := Schema{}
+	text = comments.ReplaceAllString(text, "")
+
+	for _, def := range definitions.FindAllStringSubmatch(text, -1) {
+		name, body := def[1], def[2]
+		schema[name] = map[string]string{}
+
+		found := members.FindAllStringSubmatchIndex(body, -1)
+		for i, m := range found {
+			end := len(body)
+			if i+1 < len(found) {
+				end = found[i+1][0]
+			}
+			kind, member := body[m[2]:m[3]], body[m[4]:m[5]]
+			schema[name][member] = kind + " " + whitespace.ReplaceAllString(body[m[1]:end], "")
+		}
+	}
+
+	return schema
+}
+
+// Diff returns the changes from one schema to another, ordered by element. Added and removed definitions are reported without their relations and permissions.
+func Diff(from Schema, to Schema) []Change {
+	changes := make([]Change, 0)
+
+	for name, def := range to {
+		fromDef, ok := from[name]
+		if !ok {
+			changes = append(changes, Change{Kind: Added, Element: name})
+			continue
+		}
+
+		for member, value := range def {
+			fromValue, ok := fromDef[member]
+			switch {
+			case !ok:
+				changes = append(changes, Change{Kind: Added, Element: name + "#" + member})
+			case fromValue != value:
+				changes = append(changes, Change{Kind: Modified, Element: name + "#" + member})
+			}
+		}
+		for member := range fromDef {
+			if _, ok := def[member]; !ok {
+				changes = append(changes, Change{Kind: Removed, Element: name + "#" + member})
+			}
+		}
+	}
+	for name := range from {
+		if _, ok := to[name]; !ok {
+			changes = append(changes, Change{Kind: Removed, Element: name})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Element < changes[j].Element
+	})
+	return changes
+}
+
+// formatChanges lists changes on a single line, e.g. for error messages
+func formatChanges(changes []Change) string {
+	formatted := make([]string, len(changes))
+	for i, change := range changes {
+		formatted[i] = change.String()
+	}
+	return strings.Join(formatted, ", ")
+}
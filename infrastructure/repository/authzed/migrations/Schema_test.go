@@ -0,0 +1,60 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaIsParsedIgnoringFormattingAndComments(t *testing.T) {
+	schema := ParseSchema(`
+/** a license */
+definition license {
+    relation org: org // the licensed org
+    relation seats: license_seats
+
+    permission access = seats->assigned
+    permission assignable = org->enabled_users
+        - seats->assigned
+}
+
+definition user {}`)
+
+	assert.Equal(t, Schema{
+		"license": {
+			"org":        "relation org",
+			"seats":      "relation license_seats",
+			"access":     "permission seats->assigned",
+			"assignable": "permission org->enabled_users-seats->assigned",
+		},
+		"user": {},
+	}, schema)
+}
+
+func TestDiffReportsAddedRemovedAndModifiedElements(t *testing.T) {
+	from := ParseSchema(`
+definition license {
+    relation org: org
+    relation max: max
+    permission access = seats->assigned
+}
+definition max {}
+definition old {}`)
+	to := ParseSchema(`
+definition license {
+    relation org: org
+    relation start: timestamp
+    permission access = seats->assigned - org->disabled
+}
+definition max {}
+definition timestamp {}`)
+
+	assert.Equal(t, []Change{
+		{Kind: Modified, Element: "license#access"},
+		{Kind: Removed, Element: "license#max"},
+		{Kind: Added, Element: "license#start"},
+		{Kind: Removed, Element: "old"},
+		{Kind: Added, Element: "timestamp"},
+	}, Diff(from, to))
+	assert.Empty(t, Diff(to, to))
+}
@@ -0,0 +1,92 @@
+// Package migrations brings the SpiceDB schema of a store to the version the code expects
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// versionFiles holds the complete schema of each version as versions/<number>_<name>.zed. A change of schema/spicedb_bootstrap.yaml needs a new version with the same schema.
+// Released versions are never changed. A version only adds definitions, relations, permissions or caveats to the previous one, or drops whole definitions that are no longer used.
+//
+//go:embed versions/*.zed
+var versionFiles embed.FS
+
+// Version is a version of the schema. Migrating to a version deletes the relationships of the definitions it drops and then writes its schema to the store.
+type Version struct {
+	Number int
+	Name   string
+	Schema string
+	// Dropped are the definitions of the previous version this version no longer has, SpiceDB refuses to drop them while they have relationships
+	Dropped []string
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%04d_%s", v.Number, v.Name)
+}
+
+// versions are the embedded versions, ordered by number
+var versions = mustLoadVersions()
+
+// Versions returns all versions of the schema, oldest first
+func Versions() []Version {
+	return append([]Version(nil), versions...)
+}
+
+// Latest returns the version of the schema the code expects
+func Latest() Version {
+	return versions[len(versions)-1]
+}
+
+func mustLoadVersions() []Version {
+	entries, err := versionFiles.ReadDir("versions")
+	if err != nil {
+		panic(err)
+	}
+
+	result := make([]Version, 0, len(entries))
+	for _, entry := range entries {
+		base := strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))
+		number, name, _ := strings.Cut(base, "_")
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			panic(fmt.Sprintf("schema version %s does not start with its number", entry.Name()))
+		}
+
+		content, err := versionFiles.ReadFile(path.Join("versions", entry.Name()))
+		if err != nil {
+			panic(err)
+		}
+
+		result = append(result, Version{Number: n, Name: name, Schema: string(content)})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Number < result[j].Number
+	})
+	for i, v := range result {
+		if v.Number != i+1 {
+			panic(fmt.Sprintf("schema versions are not numbered consecutively from 1: found %s at position %d", v, i+1))
+		}
+		if i > 0 {
+			result[i].Dropped = droppedDefinitions(ParseSchema(result[i-1].Schema), ParseSchema(v.Schema))
+		}
+	}
+
+	return result
+}
+
+// droppedDefinitions returns the definitions of from that to no longer has, ordered by name
+func droppedDefinitions(from Schema, to Schema) []string {
+	var dropped []string
+	for _, change := range Diff(from, to) {
+		if change.Kind == Removed && !strings.Contains(change.Element, "#") {
+			dropped = append(dropped, change.Element)
+		}
+	}
+	return dropped
+}
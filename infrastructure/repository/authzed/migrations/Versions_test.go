@@ -0,0 +1,53 @@
+package migrations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestLatestVersionIsTheBootstrapSchema(t *testing.T) {
+	// The test process runs in the directory of the package
+	content, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "schema", "spicedb_bootstrap.yaml"))
+	assert.NoError(t, err)
+	var bootstrap struct {
+		Schema string `yaml:"schema"`
+	}
+	assert.NoError(t, yaml.Unmarshal(content, &bootstrap))
+
+	assert.Empty(t, Diff(ParseSchema(bootstrap.Schema), ParseSchema(Latest().Schema)), "schema/spicedb_bootstrap.yaml changed, add it as a new version")
+}
+
+func TestVersionsAreNumberedAndNamedByTheirFiles(t *testing.T) {
+	all := Versions()
+
+	assert.Equal(t, 1, all[0].Number)
+	assert.Equal(t, "initial", all[0].Name)
+	assert.Equal(t, "0001_initial", all[0].String())
+	assert.Equal(t, all[len(all)-1], Latest())
+	for _, v := range all {
+		assert.NotEmpty(t, ParseSchema(v.Schema), v.String())
+	}
+}
+
+func TestVersionsOnlyAddToThePreviousVersionOrDropDefinitions(t *testing.T) {
+	all := Versions()
+
+	for i := 1; i < len(all); i++ {
+		changes := Diff(ParseSchema(all[i-1].Schema), ParseSchema(all[i].Schema))
+		assert.NotEmpty(t, changes, all[i].String())
+		var dropped []string
+		for _, change := range changes {
+			if change.Kind == Removed {
+				assert.NotContains(t, change.Element, "#", "%s: only whole definitions can be dropped", all[i])
+				dropped = append(dropped, change.Element)
+				continue
+			}
+			assert.Equal(t, Added, change.Kind, "%s: %s", all[i], change)
+		}
+		assert.Equal(t, dropped, all[i].Dropped, all[i].String())
+	}
+}
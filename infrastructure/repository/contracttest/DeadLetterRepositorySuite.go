@@ -0,0 +1,97 @@
+package contracttest
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// DeadLetterRepositoryFactory creates the DeadLetterRepository for a single test. Implementations may share one store across tests, the suite only uses newly generated org IDs.
+type DeadLetterRepositoryFactory func(t *testing.T) contracts.DeadLetterRepository
+
+// RunDeadLetterRepositoryTests runs the conformance suite for DeadLetterRepository implementations
+func RunDeadLetterRepositoryTests(t *testing.T, newRepository DeadLetterRepositoryFactory) {
+	tests := map[string]func(t *testing.T, deadLetters contracts.DeadLetterRepository){
+		"DeadLettersAreListedInOrder":    testDeadLettersAreListedInOrder,
+		"RemovedDeadLetterIsNotFound":    testRemovedDeadLetterIsNotFound,
+		"DeadLetterKeepsCompleteContent": testDeadLetterKeepsCompleteContent,
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			test(t, newRepository(t))
+		})
+	}
+}
+
+func testDeadLettersAreListedInOrder(t *testing.T, deadLetters contracts.DeadLetterRepository) {
+	orgID := "org-" + uuid.NewString()
+	now := time.Unix(1700000000, 0).UTC()
+	first := domain.DeadLetter{ID: domain.NewDeadLetterID(now), SubjectID: "u1", OrgID: orgID, Active: true, Reason: "subject service unavailable", Attempts: 5, Time: now}
+	second := domain.DeadLetter{ID: domain.NewDeadLetterID(now.Add(time.Second)), OrgID: orgID, Payload: `<Message><Payload attr="x & y"/></Message>`, Reason: "XML syntax error", Attempts: 1, Time: now.Add(time.Second)}
+	third := domain.DeadLetter{ID: domain.NewDeadLetterID(now.Add(2 * time.Second)), SubjectID: "u2", OrgID: orgID, Deleted: true, Reason: "conflict", Attempts: 5, Time: now.Add(2 * time.Second)}
+	assert.NoError(t, deadLetters.AddDeadLetter(context.Background(), second))
+	assert.NoError(t, deadLetters.AddDeadLetter(context.Background(), third))
+	assert.NoError(t, deadLetters.AddDeadLetter(context.Background(), first))
+
+	letters, err := deadLetters.GetDeadLetters(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.DeadLetter{first, second, third}, deadLettersOfOrg(letters, orgID))
+
+	letter, err := deadLetters.GetDeadLetter(context.Background(), second.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, second, letter)
+}
+
+func testRemovedDeadLetterIsNotFound(t *testing.T, deadLetters contracts.DeadLetterRepository) {
+	orgID := "org-" + uuid.NewString()
+	now := time.Unix(1700000000, 0).UTC()
+	letter := domain.DeadLetter{ID: domain.NewDeadLetterID(now), SubjectID: "u1", OrgID: orgID, Reason: "failed", Attempts: 3, Time: now}
+	assert.NoError(t, deadLetters.AddDeadLetter(context.Background(), letter))
+
+	assert.NoError(t, deadLetters.RemoveDeadLetter(context.Background(), letter.ID))
+
+	_, err := deadLetters.GetDeadLetter(context.Background(), letter.ID)
+	assert.ErrorIs(t, err, domain.ErrDeadLetterNotFound)
+
+	letters, err := deadLetters.GetDeadLetters(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, deadLettersOfOrg(letters, orgID))
+
+	err = deadLetters.RemoveDeadLetter(context.Background(), letter.ID)
+	assert.ErrorIs(t, err, domain.ErrDeadLetterNotFound)
+}
+
+func testDeadLetterKeepsCompleteContent(t *testing.T, deadLetters contracts.DeadLetterRepository) {
+	now := time.Unix(1700000000, 0).UTC()
+	letter := domain.DeadLetter{
+		ID:       domain.NewDeadLetterID(now),
+		OrgID:    "org-" + uuid.NewString(),
+		Payload:  "<Message>" + strings.Repeat("<Payload attr=\"ä & ö\"/>", 4000) + "</Message>",
+		Reason:   "XML syntax error: " + strings.Repeat("unexpected token ", 100),
+		Attempts: 1,
+		Time:     now,
+	}
+	assert.NoError(t, deadLetters.AddDeadLetter(context.Background(), letter))
+
+	stored, err := deadLetters.GetDeadLetter(context.Background(), letter.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, letter, stored)
+}
+
+func deadLettersOfOrg(letters []domain.DeadLetter, orgID string) []domain.DeadLetter {
+	var result []domain.DeadLetter
+	for _, letter := range letters {
+		if letter.OrgID == orgID {
+			result = append(result, letter)
+		}
+	}
+	return result
+}
@@ -0,0 +1,902 @@
+// Package contracttest contains reusable conformance tests that every implementation of the store contracts has to pass
+package contracttest
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"authz/domain/services"
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	suiteServiceID = "smarts"
+	suiteRequestor = "okay"
+)
+
+// Harness provides the repositories under test. Access, Seats and Orgs are usually the same store instance.
+type Harness struct {
+	Access contracts.AccessRepository
+	Seats  contracts.SeatLicenseRepository
+	Orgs   contracts.OrganizationRepository
+	// Outbox is the outbox of the store with recording enabled. Optional, outbox tests are skipped without it.
+	Outbox contracts.OutboxRepository
+	// WaitForConsistency is called after writes, before reads that may not be fully consistent. Optional.
+	WaitForConsistency func()
+}
+
+// HarnessFactory creates a Harness for a single test. Implementations may share one store across tests, the suite only uses newly generated org IDs.
+type HarnessFactory func(t *testing.T) Harness
+
+// RunSeatLicenseRepositoryTests runs the conformance suite for SeatLicenseRepository and OrganizationRepository implementations, including the outbox they record license events in
+func RunSeatLicenseRepositoryTests(t *testing.T, newHarness HarnessFactory) {
+	tests := map[string]func(t *testing.T, h Harness){
+		"OverAssignmentIsRejected":               testOverAssignmentIsRejected,
+		"ConcurrentAssignmentsCannotExceedLimit": testConcurrentAssignmentsCannotExceedLimit,
+		"ConcurrentSwapsCannotReplaceSameUser":   testConcurrentSwapsCannotReplaceSameUser,
+		"StaleLicenseVersionConflicts":           testStaleLicenseVersionConflicts,
+		"DisabledUserNotAssignable":              testDisabledUserNotAssignable,
+		"DisabledAssignedUserCanBeUnassigned":    testDisabledAssignedUserCanBeUnassigned,
+		"NonMemberNotAssignable":                 testNonMemberNotAssignable,
+		"UnassigningNotAssignedSeatConflicts":    testUnassigningNotAssignedSeatConflicts,
+		"FailedBatchAppliesNothing":              testFailedBatchAppliesNothing,
+		"ApplyLicenseIsIdempotent":               testApplyLicenseIsIdempotent,
+		"AssignableExcludesAssignedAndDisabled":  testAssignableExcludesAssignedAndDisabled,
+		"AddSubjectTwiceFails":                   testAddSubjectTwiceFails,
+		"SeatsAreOrderedByID":                    testSeatsAreOrderedByID,
+		"GetLicensesReturnsAllLicensesOfOrg":     testGetLicensesReturnsAllLicensesOfOrg,
+		"GetLicensesOfUnknownOrgIsEmpty":         testGetLicensesOfUnknownOrgIsEmpty,
+		"SeatAssignmentsOfSubjectAreListed":      testSeatAssignmentsOfSubjectAreListed,
+		"UnassignedSeatsAreNotListed":            testUnassignedSeatsAreNotListed,
+		"UpgradeAddsAvailableSeats":              testUpgradeAddsAvailableSeats,
+		"DowngradeBelowInUseIsRejected":          testDowngradeBelowInUseIsRejected,
+		"DowngradeBelowInUseIsReported":          testDowngradeBelowInUseIsReported,
+		"StaleLicenseUpdateConflicts":            testStaleLicenseUpdateConflicts,
+		"UpdatingUnknownLicenseFails":            testUpdatingUnknownLicenseFails,
+		"RevokeRemovesLicenseAndAssignments":     testRevokeRemovesLicenseAndAssignments,
+		"RevokingUnknownLicenseFails":            testRevokingUnknownLicenseFails,
+		"LicenseAdminCanManageLicense":           testLicenseAdminCanManageLicense,
+		"RemovedLicenseAdminCannotManageLicense": testRemovedLicenseAdminCannotManageLicense,
+		"LicenseAdminIsLimitedToItsLicense":      testLicenseAdminIsLimitedToItsLicense,
+		"RevokeRemovesLicenseAdmins":             testRevokeRemovesLicenseAdmins,
+		"LicenseAdminNeedsLicense":               testLicenseAdminNeedsLicense,
+		"LicenseTermIsPersisted":                 testLicenseTermIsPersisted,
+		"ExpiredLicenseDeniesAccess":             testExpiredLicenseDeniesAccess,
+		"LicenseDeniesAccessBeforeItsTerm":       testLicenseDeniesAccessBeforeItsTerm,
+		"OutboxRecordsLicenseChanges":            testOutboxRecordsLicenseChanges,
+		"OutboxRecordsLicenseRevocation":         testOutboxRecordsLicenseRevocation,
+		"OutboxRecordsNothingForFailedChanges":   testOutboxRecordsNothingForFailedChanges,
+		"SentEventsAreNotPending":                testSentEventsAreNotPending,
+		"RemovedSubjectIsNoMember":               testRemovedSubjectIsNoMember,
+		"RemovingSubjectReleasesItsSeats":        testRemovingSubjectReleasesItsSeats,
+		"SeatReclamationIsPersisted":             testSeatReclamationIsPersisted,
+		"ReclaimableAreAssignedDisabledSubjects": testReclaimableAreAssignedDisabledSubjects,
+		"ReclaimingSeatsRequiresPolicy":          testReclaimingSeatsRequiresPolicy,
+		"SweepReclaimsSeatsOfDisabledSubjects":   testSweepReclaimsSeatsOfDisabledSubjects,
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			test(t, newHarness(t))
+		})
+	}
+}
+
+// org is a freshly entitled organization with enabled members u0..u(n-1) and optionally disabled members d0..d(m-1)
+type org struct {
+	h     Harness
+	ID    string
+	svc   domain.Service
+	seats *services.SeatLicenseService
+}
+
+func newOrg(t *testing.T, h Harness, maxSeats int, enabled int, disabled int) *org {
+	o := &org{
+		h:     h,
+		ID:    "org-" + uuid.NewString(),
+		svc:   domain.Service{ID: suiteServiceID},
+		seats: services.NewSeatLicenseService(h.Seats, h.Access),
+	}
+
+	err := h.Seats.ApplyLicense(context.Background(), &domain.License{OrgID: o.ID, ServiceID: suiteServiceID, MaxSeats: maxSeats, Version: "l", InUse: 0})
+	if err != nil {
+		t.Fatalf("ApplyLicense failed during setup: %v", err)
+	}
+
+	for i := 0; i < enabled; i++ {
+		if err := h.Orgs.AddSubject(context.Background(), o.ID, domain.Subject{SubjectID: o.user(i), Enabled: true}); err != nil {
+			t.Fatalf("AddSubject failed during setup: %v", err)
+		}
+	}
+	for i := 0; i < disabled; i++ {
+		if err := h.Orgs.AddSubject(context.Background(), o.ID, domain.Subject{SubjectID: o.disabledUser(i), Enabled: false}); err != nil {
+			t.Fatalf("AddSubject failed during setup: %v", err)
+		}
+	}
+
+	o.settle()
+	return o
+}
+
+func (o *org) user(i int) domain.SubjectID {
+	return domain.SubjectID("u" + strconv.Itoa(i))
+}
+
+func (o *org) disabledUser(i int) domain.SubjectID {
+	return domain.SubjectID("d" + strconv.Itoa(i))
+}
+
+func (o *org) settle() {
+	if o.h.WaitForConsistency != nil {
+		o.h.WaitForConsistency()
+	}
+}
+
+func (o *org) modify(assign []domain.SubjectID, unassign []domain.SubjectID) error {
+	return o.seats.ModifySeats(context.Background(), domain.ModifySeatAssignmentEvent{
+		Request:  domain.Request{Requestor: suiteRequestor, RequestorIsOrgAdmin: true},
+		Assign:   assign,
+		UnAssign: unassign,
+		Org:      domain.Organization{ID: o.ID},
+		Service:  o.svc,
+	})
+}
+
+func (o *org) update(maxSeats int, policy domain.DowngradePolicy) (*domain.License, error) {
+	return o.seats.UpdateLicense(context.Background(), domain.UpdateLicenseEvent{
+		OrgID:           o.ID,
+		ServiceID:       suiteServiceID,
+		MaxSeats:        maxSeats,
+		DowngradePolicy: policy,
+	})
+}
+
+func (o *org) canManage(t *testing.T, subject domain.SubjectID, serviceID string) bool {
+	license := domain.License{OrgID: o.ID, ServiceID: serviceID}
+	result, err := o.h.Access.CheckAccess(context.Background(), subject, "manage_license", license.AsResource())
+	assert.NoError(t, err)
+	return bool(result)
+}
+
+func (o *org) license(t *testing.T) *domain.License {
+	lic, err := o.h.Seats.GetLicense(context.Background(), o.ID, suiteServiceID)
+	assert.NoError(t, err)
+	return lic
+}
+
+func (o *org) assigned(t *testing.T) []domain.SubjectID {
+	o.settle()
+	assigned, err := o.h.Seats.GetAssigned(context.Background(), o.ID, suiteServiceID)
+	assert.NoError(t, err)
+	return assigned
+}
+
+// pendingEvents returns the pending outbox events of this org, the outbox may be shared with other tests
+func (o *org) pendingEvents(t *testing.T) []domain.OutboxEvent {
+	o.settle()
+	evts, err := o.h.Outbox.GetPendingEvents(context.Background(), 10000)
+	assert.NoError(t, err)
+
+	var own []domain.OutboxEvent
+	for _, evt := range evts {
+		if evt.OrgID == o.ID {
+			own = append(own, evt)
+		}
+	}
+	return own
+}
+
+func (o *org) assertLicenseCountIsCorrect(t *testing.T) {
+	lic := o.license(t)
+	assigned := o.assigned(t)
+
+	assert.GreaterOrEqual(t, lic.InUse, 0)
+	assert.LessOrEqual(t, lic.InUse, lic.MaxSeats)
+	assert.Equal(t, lic.InUse, len(assigned), "Expected is the number of seats allocated on the license, actual is the number of seats actually assigned.")
+}
+
+func testOverAssignmentIsRejected(t *testing.T, h Harness) {
+	o := newOrg(t, h, 2, 3, 0)
+
+	err := o.modify([]domain.SubjectID{o.user(0), o.user(1)}, nil)
+	assert.NoError(t, err)
+
+	err = o.modify([]domain.SubjectID{o.user(2)}, nil)
+	assert.ErrorIs(t, err, domain.ErrLicenseLimitExceeded)
+
+	assert.Equal(t, 0, o.license(t).GetAvailableSeats())
+	assert.ElementsMatch(t, []domain.SubjectID{o.user(0), o.user(1)}, o.assigned(t))
+}
+
+func testConcurrentAssignmentsCannotExceedLimit(t *testing.T, h Harness) {
+	runCount := 8
+	o := newOrg(t, h, 5, runCount, 0)
+
+	errs := runConcurrently(runCount, func(run int) error {
+		return o.modify([]domain.SubjectID{o.user(run)}, nil)
+	})
+
+	for _, err := range errs {
+		if errors.Is(err, domain.ErrConflict) || errors.Is(err, domain.ErrLicenseLimitExceeded) {
+			continue
+		}
+		assert.NoError(t, err)
+	}
+
+	o.assertLicenseCountIsCorrect(t)
+}
+
+func testConcurrentSwapsCannotReplaceSameUser(t *testing.T, h Harness) {
+	runCount := 5
+	o := newOrg(t, h, 2, runCount+1, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(runCount)}, nil))
+
+	errs := runConcurrently(runCount, func(run int) error {
+		return o.modify([]domain.SubjectID{o.user(run)}, []domain.SubjectID{o.user(runCount)})
+	})
+
+	succeeded := 0
+	for _, err := range errs {
+		if errors.Is(err, domain.ErrConflict) {
+			continue
+		}
+		if assert.NoError(t, err) {
+			succeeded++
+		}
+	}
+
+	assert.LessOrEqual(t, succeeded, 1, "At most one swap may replace the user.")
+	o.assertLicenseCountIsCorrect(t)
+}
+
+func testStaleLicenseVersionConflicts(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 2, 0)
+	stale := o.license(t)
+
+	err := h.Seats.ModifySeats(context.Background(), []domain.SubjectID{o.user(0)}, nil, stale, o.ID, o.svc)
+	assert.NoError(t, err)
+
+	err = h.Seats.ModifySeats(context.Background(), []domain.SubjectID{o.user(1)}, nil, stale, o.ID, o.svc)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	assert.Equal(t, 1, o.license(t).InUse)
+	assert.ElementsMatch(t, []domain.SubjectID{o.user(0)}, o.assigned(t))
+}
+
+func testDisabledUserNotAssignable(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 0, 1)
+
+	err := o.modify([]domain.SubjectID{o.disabledUser(0)}, nil)
+	assert.Error(t, err)
+
+	assert.Equal(t, 0, o.license(t).InUse)
+	assert.Empty(t, o.assigned(t))
+}
+
+func testDisabledAssignedUserCanBeUnassigned(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 1, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0)}, nil))
+
+	err := h.Orgs.UpsertSubject(context.Background(), o.ID, domain.Subject{SubjectID: o.user(0), Enabled: false})
+	assert.NoError(t, err)
+	o.settle()
+
+	err = o.modify(nil, []domain.SubjectID{o.user(0)})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 0, o.license(t).InUse)
+	assert.Empty(t, o.assigned(t))
+}
+
+func testNonMemberNotAssignable(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 1, 0)
+
+	err := o.modify([]domain.SubjectID{"not_a_member"}, nil)
+	assert.Error(t, err)
+
+	assert.Equal(t, 0, o.license(t).InUse)
+}
+
+func testUnassigningNotAssignedSeatConflicts(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 2, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0)}, nil))
+
+	err := o.modify(nil, []domain.SubjectID{o.user(1)})
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	assert.Equal(t, 1, o.license(t).InUse)
+	assert.ElementsMatch(t, []domain.SubjectID{o.user(0)}, o.assigned(t))
+}
+
+func testFailedBatchAppliesNothing(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 2, 1)
+
+	err := o.modify([]domain.SubjectID{o.user(0), o.disabledUser(0), o.user(1)}, nil)
+	assert.Error(t, err)
+
+	assert.Equal(t, 0, o.license(t).InUse)
+	assert.Empty(t, o.assigned(t))
+}
+
+func testApplyLicenseIsIdempotent(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 1, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0)}, nil))
+
+	err := h.Seats.ApplyLicense(context.Background(), &domain.License{OrgID: o.ID, ServiceID: suiteServiceID, MaxSeats: 10, Version: "l", InUse: 0})
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	lic := o.license(t)
+	assert.Equal(t, 5, lic.MaxSeats)
+	assert.Equal(t, 1, lic.InUse)
+	assert.ElementsMatch(t, []domain.SubjectID{o.user(0)}, o.assigned(t))
+
+	licensed, err := h.Seats.HasAnyLicense(context.Background(), o.ID)
+	assert.NoError(t, err)
+	assert.True(t, licensed)
+}
+
+func testAssignableExcludesAssignedAndDisabled(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 3, 1)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0)}, nil))
+	o.settle()
+
+	assignable, err := h.Seats.GetAssignable(context.Background(), o.ID, suiteServiceID)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []domain.SubjectID{o.user(1), o.user(2)}, assignable)
+}
+
+func testSeatsAreOrderedByID(t *testing.T, h Harness) {
+	o := newOrg(t, h, 12, 12, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(11), o.user(2), o.user(10), o.user(0)}, nil))
+	o.settle()
+
+	assert.Equal(t, []domain.SubjectID{o.user(0), o.user(10), o.user(11), o.user(2)}, o.assigned(t))
+
+	assignable, err := h.Seats.GetAssignable(context.Background(), o.ID, suiteServiceID)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SubjectID{o.user(1), o.user(3), o.user(4), o.user(5), o.user(6), o.user(7), o.user(8), o.user(9)}, assignable)
+}
+
+func testGetLicensesReturnsAllLicensesOfOrg(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 2, 0)
+	assert.NoError(t, h.Seats.ApplyLicense(context.Background(), &domain.License{OrgID: o.ID, ServiceID: "ansible", MaxSeats: 3, Version: "l", InUse: 0}))
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0)}, nil))
+	o.settle()
+
+	licenses, err := h.Seats.GetLicenses(context.Background(), o.ID)
+	assert.NoError(t, err)
+	if assert.Len(t, licenses, 2) {
+		assert.Equal(t, "ansible", licenses[0].ServiceID)
+		assert.Equal(t, 3, licenses[0].GetAvailableSeats())
+		assert.Equal(t, suiteServiceID, licenses[1].ServiceID)
+		assert.Equal(t, o.ID, licenses[1].OrgID)
+		assert.Equal(t, 5, licenses[1].MaxSeats)
+		assert.Equal(t, 4, licenses[1].GetAvailableSeats())
+	}
+}
+
+func testGetLicensesOfUnknownOrgIsEmpty(t *testing.T, h Harness) {
+	licenses, err := h.Seats.GetLicenses(context.Background(), "org-"+uuid.NewString())
+	assert.NoError(t, err)
+	assert.Empty(t, licenses)
+}
+
+func testSeatAssignmentsOfSubjectAreListed(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 2, 0)
+	assert.NoError(t, h.Seats.ApplyLicense(context.Background(), &domain.License{OrgID: o.ID, ServiceID: "ansible", MaxSeats: 3, Version: "l", InUse: 0}))
+	o.settle()
+
+	before := time.Now().Truncate(time.Second)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0), o.user(1)}, nil))
+	lic, err := h.Seats.GetLicense(context.Background(), o.ID, "ansible")
+	assert.NoError(t, err)
+	assert.NoError(t, h.Seats.ModifySeats(context.Background(), []domain.SubjectID{o.user(0)}, nil, lic, o.ID, domain.Service{ID: "ansible"}))
+	o.settle()
+
+	assignments, err := h.Seats.GetSeatAssignments(context.Background(), o.ID, o.user(0))
+	assert.NoError(t, err)
+	if assert.Len(t, assignments, 2) {
+		assert.Equal(t, "ansible", assignments[0].ServiceID)
+		assert.Equal(t, suiteServiceID, assignments[1].ServiceID)
+		for _, a := range assignments {
+			assert.Equal(t, o.ID, a.OrgID)
+			assert.Equal(t, o.user(0), a.SubjectID)
+			assert.False(t, a.AssignedAt.Before(before), "Assignment time %v must not be before %v", a.AssignedAt, before)
+			assert.False(t, a.AssignedAt.After(time.Now()), "Assignment time %v must not be in the future", a.AssignedAt)
+		}
+	}
+
+	assignments, err = h.Seats.GetSeatAssignments(context.Background(), o.ID, o.user(1))
+	assert.NoError(t, err)
+	assert.Len(t, assignments, 1)
+}
+
+func testUnassignedSeatsAreNotListed(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 2, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0)}, nil))
+	assert.NoError(t, o.modify(nil, []domain.SubjectID{o.user(0)}))
+	o.settle()
+
+	assignments, err := h.Seats.GetSeatAssignments(context.Background(), o.ID, o.user(0))
+	assert.NoError(t, err)
+	assert.Empty(t, assignments)
+
+	assignments, err = h.Seats.GetSeatAssignments(context.Background(), "org-"+uuid.NewString(), o.user(1))
+	assert.NoError(t, err)
+	assert.Empty(t, assignments)
+}
+
+func testAddSubjectTwiceFails(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 1, 0)
+
+	err := h.Orgs.AddSubject(context.Background(), o.ID, domain.Subject{SubjectID: o.user(0), Enabled: false})
+	assert.ErrorIs(t, err, domain.ErrSubjectAlreadyExists)
+	o.settle()
+
+	assignable, err := h.Seats.GetAssignable(context.Background(), o.ID, suiteServiceID)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []domain.SubjectID{o.user(0)}, assignable) //Still enabled
+}
+
+func testUpgradeAddsAvailableSeats(t *testing.T, h Harness) {
+	o := newOrg(t, h, 2, 3, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0), o.user(1)}, nil))
+
+	lic, err := o.update(4, domain.DowngradePolicyReject)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, lic.MaxSeats)
+	assert.Equal(t, 2, lic.GetAvailableSeats())
+
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(2)}, nil))
+	lic = o.license(t)
+	assert.Equal(t, 4, lic.MaxSeats)
+	assert.Equal(t, 3, lic.InUse)
+	o.assertLicenseCountIsCorrect(t)
+}
+
+func testDowngradeBelowInUseIsRejected(t *testing.T, h Harness) {
+	o := newOrg(t, h, 3, 2, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0), o.user(1)}, nil))
+
+	_, err := o.update(1, domain.DowngradePolicyReject)
+	assert.ErrorIs(t, err, domain.ErrLicenseLimitExceeded)
+
+	lic := o.license(t)
+	assert.Equal(t, 3, lic.MaxSeats)
+	assert.Equal(t, 2, lic.InUse)
+
+	lic, err = o.update(2, domain.DowngradePolicyReject)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, lic.MaxSeats)
+	assert.Equal(t, 0, lic.GetAvailableSeats())
+}
+
+func testDowngradeBelowInUseIsReported(t *testing.T, h Harness) {
+	o := newOrg(t, h, 3, 3, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0), o.user(1)}, nil))
+
+	lic, err := o.update(1, domain.DowngradePolicyReport)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, lic.MaxSeats)
+	assert.Equal(t, 1, lic.GetOverage())
+	assert.Equal(t, 0, lic.GetAvailableSeats())
+
+	err = o.modify([]domain.SubjectID{o.user(2)}, nil)
+	assert.ErrorIs(t, err, domain.ErrLicenseLimitExceeded)
+
+	assert.NoError(t, o.modify(nil, []domain.SubjectID{o.user(0)}))
+	lic = o.license(t)
+	assert.Equal(t, 1, lic.InUse)
+	assert.Equal(t, 0, lic.GetOverage())
+}
+
+func testStaleLicenseUpdateConflicts(t *testing.T, h Harness) {
+	o := newOrg(t, h, 3, 1, 0)
+	stale := o.license(t)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0)}, nil))
+
+	updated := *stale
+	updated.MaxSeats = 5
+	updated.Version = domain.NewLicenseVersion()
+	err := h.Seats.UpdateLicense(context.Background(), stale, &updated)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	lic := o.license(t)
+	assert.Equal(t, 3, lic.MaxSeats)
+	assert.Equal(t, 1, lic.InUse)
+}
+
+func testUpdatingUnknownLicenseFails(t *testing.T, h Harness) {
+	seats := services.NewSeatLicenseService(h.Seats, h.Access)
+
+	_, err := seats.UpdateLicense(context.Background(), domain.UpdateLicenseEvent{
+		OrgID:           "org-" + uuid.NewString(),
+		ServiceID:       suiteServiceID,
+		MaxSeats:        5,
+		DowngradePolicy: domain.DowngradePolicyReject,
+	})
+	assert.ErrorIs(t, err, domain.ErrLicenseNotFound)
+}
+
+func testRevokeRemovesLicenseAndAssignments(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 3, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0), o.user(1)}, nil))
+
+	err := h.Seats.RevokeLicense(context.Background(), o.ID, suiteServiceID)
+	assert.NoError(t, err)
+
+	assert.False(t, o.license(t).Exists())
+	assert.Empty(t, o.assigned(t))
+
+	licensed, err := h.Seats.HasAnyLicense(context.Background(), o.ID)
+	assert.NoError(t, err)
+	assert.False(t, licensed)
+
+	access, err := h.Access.CheckAccess(context.Background(), o.user(0), "access", domain.Resource{Type: "license", ID: o.ID + "/" + suiteServiceID})
+	assert.NoError(t, err)
+	assert.False(t, bool(access))
+
+	// The org can be entitled again from scratch
+	err = h.Seats.ApplyLicense(context.Background(), &domain.License{OrgID: o.ID, ServiceID: suiteServiceID, MaxSeats: 2, Version: "l", InUse: 0})
+	assert.NoError(t, err)
+	o.settle()
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(2)}, nil))
+	assert.ElementsMatch(t, []domain.SubjectID{o.user(2)}, o.assigned(t))
+}
+
+func testRevokingUnknownLicenseFails(t *testing.T, h Harness) {
+	err := h.Seats.RevokeLicense(context.Background(), "org-"+uuid.NewString(), suiteServiceID)
+	assert.ErrorIs(t, err, domain.ErrLicenseNotFound)
+}
+
+func testLicenseAdminCanManageLicense(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 3, 0)
+
+	assert.NoError(t, h.Seats.AddLicenseAdmin(context.Background(), o.ID, suiteServiceID, o.user(0)))
+	assert.NoError(t, h.Seats.AddLicenseAdmin(context.Background(), o.ID, suiteServiceID, o.user(0))) // no-op
+	o.settle()
+
+	assert.True(t, o.canManage(t, o.user(0), suiteServiceID))
+	assert.False(t, o.canManage(t, o.user(1), suiteServiceID))
+}
+
+func testRemovedLicenseAdminCannotManageLicense(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 3, 0)
+	assert.NoError(t, h.Seats.AddLicenseAdmin(context.Background(), o.ID, suiteServiceID, o.user(0)))
+	o.settle()
+
+	assert.NoError(t, h.Seats.RemoveLicenseAdmin(context.Background(), o.ID, suiteServiceID, o.user(0)))
+	assert.NoError(t, h.Seats.RemoveLicenseAdmin(context.Background(), o.ID, suiteServiceID, o.user(0))) // no-op
+	o.settle()
+
+	assert.False(t, o.canManage(t, o.user(0), suiteServiceID))
+}
+
+func testLicenseAdminIsLimitedToItsLicense(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 3, 0)
+	err := h.Seats.ApplyLicense(context.Background(), &domain.License{OrgID: o.ID, ServiceID: "other", MaxSeats: 5, Version: "l", InUse: 0})
+	assert.NoError(t, err)
+	assert.NoError(t, h.Seats.AddLicenseAdmin(context.Background(), o.ID, suiteServiceID, o.user(0)))
+	o.settle()
+
+	assert.False(t, o.canManage(t, o.user(0), "other"))
+}
+
+func testRevokeRemovesLicenseAdmins(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 3, 0)
+	assert.NoError(t, h.Seats.AddLicenseAdmin(context.Background(), o.ID, suiteServiceID, o.user(0)))
+
+	assert.NoError(t, h.Seats.RevokeLicense(context.Background(), o.ID, suiteServiceID))
+	err := h.Seats.ApplyLicense(context.Background(), &domain.License{OrgID: o.ID, ServiceID: suiteServiceID, MaxSeats: 2, Version: "l", InUse: 0})
+	assert.NoError(t, err)
+	o.settle()
+
+	assert.False(t, o.canManage(t, o.user(0), suiteServiceID))
+}
+
+func testLicenseAdminNeedsLicense(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 3, 0)
+
+	err := h.Seats.AddLicenseAdmin(context.Background(), o.ID, "other", o.user(0))
+	assert.ErrorIs(t, err, domain.ErrLicenseNotFound)
+	o.settle()
+
+	assert.False(t, o.canManage(t, o.user(0), "other"))
+}
+
+func testLicenseTermIsPersisted(t *testing.T, h Harness) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC()
+	orgID := "org-" + uuid.NewString()
+
+	err := h.Seats.ApplyLicense(context.Background(), &domain.License{OrgID: orgID, ServiceID: suiteServiceID, MaxSeats: 5, Version: "l", StartDate: start, EndDate: end})
+	assert.NoError(t, err)
+
+	lic, err := h.Seats.GetLicense(context.Background(), orgID, suiteServiceID)
+	assert.NoError(t, err)
+	assert.True(t, start.Equal(lic.StartDate))
+	assert.True(t, end.Equal(lic.EndDate))
+}
+
+func testExpiredLicenseDeniesAccess(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 1, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0)}, nil))
+	expired := &org{h: h, ID: "org-" + uuid.NewString(), svc: o.svc, seats: o.seats}
+	err := h.Seats.ApplyLicense(context.Background(), &domain.License{OrgID: expired.ID, ServiceID: suiteServiceID, MaxSeats: 5, Version: "l", EndDate: time.Now().Add(-time.Hour)})
+	assert.NoError(t, err)
+	assert.NoError(t, h.Orgs.AddSubject(context.Background(), expired.ID, domain.Subject{SubjectID: expired.user(0), Enabled: true}))
+	expired.settle()
+	assert.NoError(t, expired.modify([]domain.SubjectID{expired.user(0)}, nil))
+	o.settle()
+
+	access, err := h.Access.CheckAccess(context.Background(), o.user(0), "access", domain.Resource{Type: "license", ID: o.ID + "/" + suiteServiceID})
+	assert.NoError(t, err)
+	assert.True(t, bool(access))
+
+	access, err = h.Access.CheckAccess(context.Background(), expired.user(0), "access", domain.Resource{Type: "license", ID: expired.ID + "/" + suiteServiceID})
+	assert.NoError(t, err)
+	assert.False(t, bool(access))
+}
+
+func testLicenseDeniesAccessBeforeItsTerm(t *testing.T, h Harness) {
+	o := &org{h: h, ID: "org-" + uuid.NewString(), svc: domain.Service{ID: suiteServiceID}, seats: services.NewSeatLicenseService(h.Seats, h.Access)}
+	err := h.Seats.ApplyLicense(context.Background(), &domain.License{OrgID: o.ID, ServiceID: suiteServiceID, MaxSeats: 5, Version: "l", StartDate: time.Now().Add(time.Hour).Truncate(time.Second)})
+	assert.NoError(t, err)
+	assert.NoError(t, h.Orgs.AddSubject(context.Background(), o.ID, domain.Subject{SubjectID: o.user(0), Enabled: true}))
+	o.settle()
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0)}, nil))
+	o.settle()
+
+	access, err := h.Access.CheckAccess(context.Background(), o.user(0), "access", domain.Resource{Type: "license", ID: o.ID + "/" + suiteServiceID})
+	assert.NoError(t, err)
+	assert.False(t, bool(access))
+
+	// The term only restricts access, the seat stays assigned
+	assigned, err := h.Seats.GetAssigned(context.Background(), o.ID, suiteServiceID)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SubjectID{o.user(0)}, assigned)
+}
+
+func testOutboxRecordsLicenseChanges(t *testing.T, h Harness) {
+	if h.Outbox == nil {
+		t.Skip("store has no outbox")
+	}
+
+	o := newOrg(t, h, 5, 3, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0), o.user(1)}, nil))
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(2)}, []domain.SubjectID{o.user(0)}))
+	_, err := o.update(7, domain.DowngradePolicyReject)
+	assert.NoError(t, err)
+
+	var types []domain.LicenseEventType
+	var subjects []domain.SubjectID
+	var available []int
+	var previous []int
+	for _, evt := range o.pendingEvents(t) {
+		assert.Equal(t, suiteServiceID, evt.ServiceID)
+		types = append(types, evt.Type)
+		subjects = append(subjects, evt.SubjectID)
+		available = append(available, evt.SeatsAvailable)
+		previous = append(previous, evt.PreviousSeatsTotal)
+	}
+
+	assert.Equal(t, []domain.LicenseEventType{domain.LicenseEntitled, domain.SeatAssigned, domain.SeatAssigned, domain.SeatUnassigned, domain.SeatAssigned, domain.LicenseUpdated}, types)
+	assert.Equal(t, []domain.SubjectID{"", o.user(0), o.user(1), o.user(0), o.user(2), ""}, subjects)
+	assert.Equal(t, []int{5, 3, 3, 3, 3, 5}, available)
+	assert.Equal(t, []int{0, 0, 0, 0, 0, 5}, previous)
+}
+
+func testOutboxRecordsLicenseRevocation(t *testing.T, h Harness) {
+	if h.Outbox == nil {
+		t.Skip("store has no outbox")
+	}
+
+	o := newOrg(t, h, 5, 2, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0), o.user(1)}, nil))
+	assert.NoError(t, h.Seats.RevokeLicense(context.Background(), o.ID, suiteServiceID))
+	assert.ErrorIs(t, h.Seats.RevokeLicense(context.Background(), o.ID, suiteServiceID), domain.ErrLicenseNotFound)
+
+	evts := o.pendingEvents(t)
+	if assert.Len(t, evts, 4) {
+		assert.Equal(t, domain.LicenseEvent{Type: domain.LicenseRevoked, OrgID: o.ID, ServiceID: suiteServiceID, PreviousSeatsTotal: 5}, evts[3].LicenseEvent)
+	}
+}
+
+func testOutboxRecordsNothingForFailedChanges(t *testing.T, h Harness) {
+	if h.Outbox == nil {
+		t.Skip("store has no outbox")
+	}
+
+	o := newOrg(t, h, 1, 2, 0)
+	assert.Error(t, o.modify([]domain.SubjectID{o.user(0), o.user(1)}, nil))
+
+	evts := o.pendingEvents(t)
+	if assert.Len(t, evts, 1) {
+		assert.Equal(t, domain.LicenseEntitled, evts[0].Type)
+	}
+}
+
+func testSentEventsAreNotPending(t *testing.T, h Harness) {
+	if h.Outbox == nil {
+		t.Skip("store has no outbox")
+	}
+
+	o := newOrg(t, h, 5, 2, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0)}, nil))
+
+	evts := o.pendingEvents(t)
+	assert.Len(t, evts, 2)
+
+	assert.NoError(t, h.Outbox.MarkEventsSent(context.Background(), []string{evts[0].ID, "unknown"}))
+	remaining := o.pendingEvents(t)
+	assert.Equal(t, evts[1:], remaining)
+
+	assert.NoError(t, h.Outbox.MarkEventsSent(context.Background(), []string{evts[1].ID}))
+	assert.Empty(t, o.pendingEvents(t))
+}
+
+func testRemovedSubjectIsNoMember(t *testing.T, h Harness) {
+	o := newOrg(t, h, 2, 2, 1)
+	other := newOrg(t, h, 1, 1, 0)
+
+	orgs, err := h.Orgs.GetSubjectOrgs(context.Background(), o.user(0))
+	assert.NoError(t, err)
+	assert.Contains(t, orgs, o.ID)
+	assert.Contains(t, orgs, other.ID)
+
+	assert.NoError(t, h.Orgs.RemoveSubject(context.Background(), o.ID, o.user(0)))
+	assert.NoError(t, h.Orgs.RemoveSubject(context.Background(), o.ID, o.disabledUser(0)))
+	o.settle()
+
+	orgs, err = h.Orgs.GetSubjectOrgs(context.Background(), o.user(0))
+	assert.NoError(t, err)
+	assert.NotContains(t, orgs, o.ID)
+	assert.Contains(t, orgs, other.ID)
+
+	assignable, err := h.Seats.GetAssignable(context.Background(), o.ID, suiteServiceID)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SubjectID{o.user(1)}, assignable)
+
+	// Removing a subject that is no member is a no-op
+	assert.NoError(t, h.Orgs.RemoveSubject(context.Background(), o.ID, o.user(0)))
+
+	// A removed disabled subject that joins again is enabled
+	assert.NoError(t, h.Orgs.AddSubject(context.Background(), o.ID, domain.Subject{SubjectID: o.disabledUser(0), Enabled: true}))
+	o.settle()
+	assignable, err = h.Seats.GetAssignable(context.Background(), o.ID, suiteServiceID)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []domain.SubjectID{o.disabledUser(0), o.user(1)}, assignable)
+}
+
+func testRemovingSubjectReleasesItsSeats(t *testing.T, h Harness) {
+	o := newOrg(t, h, 2, 2, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0), o.user(1)}, nil))
+	assert.NoError(t, h.Seats.AddLicenseAdmin(context.Background(), o.ID, suiteServiceID, o.user(0)))
+	o.settle()
+
+	err := services.NewMembershipService(h.Seats, h.Orgs).RemoveSubject(context.Background(), o.ID, o.user(0))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []domain.SubjectID{o.user(1)}, o.assigned(t))
+	assert.Equal(t, 1, o.license(t).InUse)
+	assert.False(t, o.canManage(t, o.user(0), suiteServiceID))
+	o.assertLicenseCountIsCorrect(t)
+
+	orgs, err := h.Orgs.GetSubjectOrgs(context.Background(), o.user(0))
+	assert.NoError(t, err)
+	assert.NotContains(t, orgs, o.ID)
+}
+
+func testSeatReclamationIsPersisted(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 1, 0)
+	assert.False(t, o.license(t).ReclaimDisabledSeats)
+
+	assert.NoError(t, h.Seats.SetSeatReclamation(context.Background(), o.ID, suiteServiceID, true))
+	o.settle()
+	assert.True(t, o.license(t).ReclaimDisabledSeats)
+	assert.Contains(t, o.reclaimingLicenses(t), o.ID+"/"+suiteServiceID)
+
+	// Enabling it again is a no-op
+	assert.NoError(t, h.Seats.SetSeatReclamation(context.Background(), o.ID, suiteServiceID, true))
+
+	assert.NoError(t, h.Seats.SetSeatReclamation(context.Background(), o.ID, suiteServiceID, false))
+	o.settle()
+	assert.False(t, o.license(t).ReclaimDisabledSeats)
+	assert.NotContains(t, o.reclaimingLicenses(t), o.ID+"/"+suiteServiceID)
+
+	// The policy is revoked together with the license
+	assert.NoError(t, h.Seats.SetSeatReclamation(context.Background(), o.ID, suiteServiceID, true))
+	assert.NoError(t, h.Seats.RevokeLicense(context.Background(), o.ID, suiteServiceID))
+	o.settle()
+	assert.NotContains(t, o.reclaimingLicenses(t), o.ID+"/"+suiteServiceID)
+
+	err := h.Seats.SetSeatReclamation(context.Background(), o.ID, suiteServiceID, true)
+	assert.ErrorIs(t, err, domain.ErrLicenseNotFound)
+}
+
+func testReclaimableAreAssignedDisabledSubjects(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 3, 1)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0), o.user(1)}, nil))
+	assert.NoError(t, h.Orgs.UpsertSubject(context.Background(), o.ID, domain.Subject{SubjectID: o.user(0), Enabled: false}))
+	assert.NoError(t, h.Orgs.UpsertSubject(context.Background(), o.ID, domain.Subject{SubjectID: o.user(2), Enabled: false}))
+
+	reclaimable, err := h.Seats.GetReclaimable(context.Background(), o.ID, suiteServiceID)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SubjectID{o.user(0)}, reclaimable)
+}
+
+func testReclaimingSeatsRequiresPolicy(t *testing.T, h Harness) {
+	o := newOrg(t, h, 5, 2, 0)
+	assert.NoError(t, o.modify([]domain.SubjectID{o.user(0), o.user(1)}, nil))
+	assert.NoError(t, h.Orgs.UpsertSubject(context.Background(), o.ID, domain.Subject{SubjectID: o.user(0), Enabled: false}))
+	reclamation := services.NewSeatReclamationService(h.Seats)
+
+	reclaimed, err := reclamation.ReclaimSeats(context.Background(), o.ID, suiteServiceID)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, reclaimed)
+	assert.Equal(t, []domain.SubjectID{o.user(0), o.user(1)}, o.assigned(t))
+
+	assert.NoError(t, h.Seats.SetSeatReclamation(context.Background(), o.ID, suiteServiceID, true))
+	o.settle()
+	reclaimed, err = reclamation.ReclaimSeats(context.Background(), o.ID, suiteServiceID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reclaimed)
+	assert.Equal(t, []domain.SubjectID{o.user(1)}, o.assigned(t))
+	o.assertLicenseCountIsCorrect(t)
+}
+
+func testSweepReclaimsSeatsOfDisabledSubjects(t *testing.T, h Harness) {
+	reclaiming := newOrg(t, h, 5, 2, 0)
+	other := newOrg(t, h, 5, 1, 0)
+	for _, o := range []*org{reclaiming, other} {
+		assert.NoError(t, o.modify([]domain.SubjectID{o.user(0)}, nil))
+		assert.NoError(t, h.Orgs.UpsertSubject(context.Background(), o.ID, domain.Subject{SubjectID: o.user(0), Enabled: false}))
+	}
+	assert.NoError(t, reclaiming.modify([]domain.SubjectID{reclaiming.user(1)}, nil))
+	assert.NoError(t, h.Seats.SetSeatReclamation(context.Background(), reclaiming.ID, suiteServiceID, true))
+	reclaiming.settle()
+
+	// Licenses of other tests may be swept as well, so only this test's orgs are checked
+	_, err := services.NewSeatReclamationService(h.Seats).Sweep(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, []domain.SubjectID{reclaiming.user(1)}, reclaiming.assigned(t))
+	reclaiming.assertLicenseCountIsCorrect(t)
+	assert.Equal(t, []domain.SubjectID{other.user(0)}, other.assigned(t))
+	other.assertLicenseCountIsCorrect(t)
+}
+
+// reclaimingLicenses returns the IDs of all licenses with seat reclamation enabled, which may include licenses of other tests
+func (o *org) reclaimingLicenses(t *testing.T) []string {
+	licenses, err := o.h.Seats.GetLicensesReclaimingSeats(context.Background())
+	assert.NoError(t, err)
+
+	ids := make([]string, 0, len(licenses))
+	for _, license := range licenses {
+		ids = append(ids, license.OrgID+"/"+license.ServiceID)
+	}
+	return ids
+}
+
+func runConcurrently(runCount int, run func(run int) error) []error {
+	wait := &sync.WaitGroup{}
+	errs := make([]error, runCount)
+	wait.Add(runCount)
+	for i := 0; i < runCount; i++ {
+		go func(i int) {
+			defer wait.Done()
+			errs[i] = run(i)
+		}(i)
+	}
+	wait.Wait()
+
+	return errs
+}
@@ -0,0 +1,69 @@
+package contracttest
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// WebhookRepositoryFactory creates the WebhookRepository for a single test. Implementations may share one store across tests, the suite only uses newly generated org IDs.
+type WebhookRepositoryFactory func(t *testing.T) contracts.WebhookRepository
+
+// RunWebhookRepositoryTests runs the conformance suite for WebhookRepository implementations
+func RunWebhookRepositoryTests(t *testing.T, newRepository WebhookRepositoryFactory) {
+	tests := map[string]func(t *testing.T, webhooks contracts.WebhookRepository){
+		"WebhooksAreListedPerOrg":   testWebhooksAreListedPerOrg,
+		"RemovedWebhookIsNotListed": testRemovedWebhookIsNotListed,
+	}
+
+	for name, test := range tests {
+		test := test
+		t.Run(name, func(t *testing.T) {
+			test(t, newRepository(t))
+		})
+	}
+}
+
+func testWebhooksAreListedPerOrg(t *testing.T, webhooks contracts.WebhookRepository) {
+	orgID := "org-" + uuid.NewString()
+	first := domain.Webhook{ID: domain.NewWebhookID(), OrgID: orgID, ServiceID: suiteServiceID, URL: "https://example.com/hook?a=1&b=2", Secret: "s3cr3t-s3cr3t-s3cr3t"}
+	second := domain.Webhook{ID: domain.NewWebhookID(), OrgID: orgID, URL: "https://example.org/hook", Secret: "another-secret-value"}
+	assert.NoError(t, webhooks.AddWebhook(context.Background(), first))
+	assert.NoError(t, webhooks.AddWebhook(context.Background(), second))
+	assert.NoError(t, webhooks.AddWebhook(context.Background(), domain.Webhook{ID: domain.NewWebhookID(), OrgID: "org-" + uuid.NewString(), URL: "https://example.net/hook", Secret: "yet-another-secret"}))
+
+	expected := []domain.Webhook{first, second}
+	if second.ID < first.ID {
+		expected = []domain.Webhook{second, first}
+	}
+
+	listed, err := webhooks.GetWebhooks(context.Background(), orgID)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, listed)
+
+	listed, err = webhooks.GetWebhooks(context.Background(), "org-"+uuid.NewString())
+	assert.NoError(t, err)
+	assert.Empty(t, listed)
+}
+
+func testRemovedWebhookIsNotListed(t *testing.T, webhooks contracts.WebhookRepository) {
+	orgID := "org-" + uuid.NewString()
+	webhook := domain.Webhook{ID: domain.NewWebhookID(), OrgID: orgID, URL: "https://example.com/hook", Secret: "s3cr3t-s3cr3t-s3cr3t"}
+	assert.NoError(t, webhooks.AddWebhook(context.Background(), webhook))
+
+	err := webhooks.RemoveWebhook(context.Background(), "org-"+uuid.NewString(), webhook.ID)
+	assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+
+	assert.NoError(t, webhooks.RemoveWebhook(context.Background(), orgID, webhook.ID))
+
+	listed, err := webhooks.GetWebhooks(context.Background(), orgID)
+	assert.NoError(t, err)
+	assert.Empty(t, listed)
+
+	err = webhooks.RemoveWebhook(context.Background(), orgID, webhook.ID)
+	assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+}
@@ -0,0 +1,107 @@
+package filestore
+
+import (
+	"authz/domain"
+	"context"
+	"errors"
+	"io/fs"
+	"time"
+)
+
+// FileDeadLetterRepository keeps dead letters as one file per dead letter, with the complete raw message and reason
+type FileDeadLetterRepository struct {
+	dir directory
+}
+
+// deadLetterDocument is the serialized form of a domain.DeadLetter
+type deadLetterDocument struct {
+	SubjectID string    `json:"subjectId,omitempty"`
+	OrgID     string    `json:"orgId,omitempty"`
+	Active    bool      `json:"active"`
+	Deleted   bool      `json:"deleted"`
+	Payload   string    `json:"payload,omitempty"`
+	Reason    string    `json:"reason"`
+	Attempts  int       `json:"attempts"`
+	Time      time.Time `json:"time"`
+}
+
+// NewFileDeadLetterRepository constructs a new FileDeadLetterRepository keeping its files in the dead-letters directory below stateDir
+func NewFileDeadLetterRepository(stateDir string) (*FileDeadLetterRepository, error) {
+	dir, err := openDirectory(stateDir, "dead-letters")
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileDeadLetterRepository{dir: dir}, nil
+}
+
+// AddDeadLetter stores a new dead letter
+func (r *FileDeadLetterRepository) AddDeadLetter(_ context.Context, letter domain.DeadLetter) error {
+	return r.dir.put(letter.ID, deadLetterDocument{
+		SubjectID: string(letter.SubjectID),
+		OrgID:     letter.OrgID,
+		Active:    letter.Active,
+		Deleted:   letter.Deleted,
+		Payload:   letter.Payload,
+		Reason:    letter.Reason,
+		Attempts:  letter.Attempts,
+		Time:      letter.Time,
+	})
+}
+
+// GetDeadLetters retrieves all dead letters, ordered by ID
+func (r *FileDeadLetterRepository) GetDeadLetters(ctx context.Context) ([]domain.DeadLetter, error) {
+	ids, err := r.dir.ids()
+	if err != nil {
+		return nil, err
+	}
+
+	letters := make([]domain.DeadLetter, 0, len(ids))
+	for _, id := range ids {
+		letter, err := r.GetDeadLetter(ctx, id)
+		if errors.Is(err, domain.ErrDeadLetterNotFound) {
+			continue // removed concurrently
+		}
+		if err != nil {
+			return nil, err
+		}
+		letters = append(letters, letter)
+	}
+
+	return letters, nil
+}
+
+// GetDeadLetter retrieves a single dead letter
+func (r *FileDeadLetterRepository) GetDeadLetter(_ context.Context, id string) (domain.DeadLetter, error) {
+	var doc deadLetterDocument
+	if err := r.dir.get(id, &doc); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return domain.DeadLetter{}, domain.ErrDeadLetterNotFound
+		}
+		return domain.DeadLetter{}, err
+	}
+
+	return domain.DeadLetter{
+		ID:        id,
+		SubjectID: domain.SubjectID(doc.SubjectID),
+		OrgID:     doc.OrgID,
+		Active:    doc.Active,
+		Deleted:   doc.Deleted,
+		Payload:   doc.Payload,
+		Reason:    doc.Reason,
+		Attempts:  doc.Attempts,
+		Time:      doc.Time,
+	}, nil
+}
+
+// RemoveDeadLetter deletes a dead letter
+func (r *FileDeadLetterRepository) RemoveDeadLetter(_ context.Context, id string) error {
+	if err := r.dir.remove(id); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return domain.ErrDeadLetterNotFound
+		}
+		return err
+	}
+
+	return nil
+}
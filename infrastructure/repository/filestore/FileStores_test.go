@@ -0,0 +1,25 @@
+package filestore
+
+import (
+	"authz/domain/contracts"
+	"authz/infrastructure/repository/contracttest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileWebhookRepositoryConformance(t *testing.T) {
+	contracttest.RunWebhookRepositoryTests(t, func(t *testing.T) contracts.WebhookRepository {
+		webhooks, err := NewFileWebhookRepository(t.TempDir(), testKey)
+		assert.NoError(t, err)
+		return webhooks
+	})
+}
+
+func TestFileDeadLetterRepositoryConformance(t *testing.T) {
+	contracttest.RunDeadLetterRepositoryTests(t, func(t *testing.T) contracts.DeadLetterRepository {
+		deadLetters, err := NewFileDeadLetterRepository(t.TempDir())
+		assert.NoError(t, err)
+		return deadLetters
+	})
+}
@@ -0,0 +1,104 @@
+package filestore
+
+import (
+	"authz/domain"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+)
+
+// FileWebhookDeliveryLog keeps the most recent delivery attempts as one file per attempt in a directory per webhook, so that all replicas sharing the state directory see the attempts made by any of them
+type FileWebhookDeliveryLog struct {
+	dir        directory
+	maxEntries int
+}
+
+// webhookDeliveryDocument is the serialized form of a domain.WebhookDelivery
+type webhookDeliveryDocument struct {
+	Event      licenseEventDocument `json:"event"`
+	Attempt    int                  `json:"attempt"`
+	Time       time.Time            `json:"time"`
+	StatusCode int                  `json:"statusCode,omitempty"`
+	Error      string               `json:"error,omitempty"`
+}
+
+// NewFileWebhookDeliveryLog constructs a new FileWebhookDeliveryLog keeping up to maxEntries deliveries per webhook in the webhook-delivery-log directory below stateDir
+func NewFileWebhookDeliveryLog(stateDir string, maxEntries int) (*FileWebhookDeliveryLog, error) {
+	dir, err := openDirectory(stateDir, "webhook-delivery-log")
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileWebhookDeliveryLog{dir: dir, maxEntries: maxEntries}, nil
+}
+
+// AddDelivery records an attempt to deliver an event, discarding the oldest deliveries to the webhook if the log is full
+func (l *FileWebhookDeliveryLog) AddDelivery(_ context.Context, delivery domain.WebhookDelivery) error {
+	webhookDir, err := l.dir.sub(delivery.WebhookID)
+	if err != nil {
+		return err
+	}
+
+	err = webhookDir.put(domain.NewWebhookDeliveryID(delivery.Time), webhookDeliveryDocument{
+		Event:      newLicenseEventDocument(delivery.Event),
+		Attempt:    delivery.Attempt,
+		Time:       delivery.Time,
+		StatusCode: delivery.StatusCode,
+		Error:      delivery.Error,
+	})
+	if err != nil {
+		return fmt.Errorf("error recording delivery to webhook %s: %w", delivery.WebhookID, err)
+	}
+
+	ids, err := webhookDir.ids()
+	if err != nil {
+		return err
+	}
+
+	for len(ids) > l.maxEntries {
+		// Another replica may discard the same delivery concurrently
+		if err := webhookDir.remove(ids[0]); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		ids = ids[1:]
+	}
+
+	return nil
+}
+
+// GetDeliveries retrieves the recorded attempts to deliver events to a webhook, most recent first
+func (l *FileWebhookDeliveryLog) GetDeliveries(_ context.Context, webhookID string) ([]domain.WebhookDelivery, error) {
+	webhookDir, err := l.dir.sub(webhookID)
+	if err != nil {
+		return []domain.WebhookDelivery{}, nil
+	}
+
+	ids, err := webhookDir.ids()
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(ids))
+	for i := len(ids) - 1; i >= 0; i-- {
+		var doc webhookDeliveryDocument
+		if err := webhookDir.get(ids[i], &doc); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue // discarded concurrently
+			}
+			return nil, err
+		}
+
+		deliveries = append(deliveries, domain.WebhookDelivery{
+			WebhookID:  webhookID,
+			Event:      doc.Event.licenseEvent(),
+			Attempt:    doc.Attempt,
+			Time:       doc.Time,
+			StatusCode: doc.StatusCode,
+			Error:      doc.Error,
+		})
+	}
+
+	return deliveries, nil
+}
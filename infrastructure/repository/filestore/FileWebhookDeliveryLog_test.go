@@ -0,0 +1,51 @@
+package filestore
+
+import (
+	"authz/domain"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliveriesAreSharedAcrossReplicasMostRecentFirst(t *testing.T) {
+	stateDir := t.TempDir()
+	replica1, err := NewFileWebhookDeliveryLog(stateDir, 10)
+	assert.NoError(t, err)
+	replica2, err := NewFileWebhookDeliveryLog(stateDir, 10)
+	assert.NoError(t, err)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	evt := domain.LicenseEvent{Type: domain.SeatAssigned, OrgID: "o1", ServiceID: "smarts", SubjectID: "u1", SeatsTotal: 5, SeatsAvailable: 4}
+	failed := domain.WebhookDelivery{WebhookID: "w1", Event: evt, Attempt: 1, Time: now, StatusCode: 500, Error: "webhook responded with status 500"}
+	succeeded := domain.WebhookDelivery{WebhookID: "w1", Event: evt, Attempt: 2, Time: now.Add(time.Second), StatusCode: 200}
+	assert.NoError(t, replica1.AddDelivery(context.Background(), failed))
+	assert.NoError(t, replica2.AddDelivery(context.Background(), domain.WebhookDelivery{WebhookID: "w2", Event: evt, Attempt: 1, Time: now}))
+	assert.NoError(t, replica2.AddDelivery(context.Background(), succeeded))
+
+	deliveries, err := replica1.GetDeliveries(context.Background(), "w1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.WebhookDelivery{succeeded, failed}, deliveries)
+}
+
+func TestFileDeliveryLogDiscardsOldestDeliveries(t *testing.T) {
+	log, err := NewFileWebhookDeliveryLog(t.TempDir(), 2)
+	assert.NoError(t, err)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i := 1; i <= 3; i++ {
+		assert.NoError(t, log.AddDelivery(context.Background(), domain.WebhookDelivery{WebhookID: "w1", Attempt: i, Time: now.Add(time.Duration(i) * time.Second)}))
+	}
+
+	deliveries, err := log.GetDeliveries(context.Background(), "w1")
+
+	assert.NoError(t, err)
+	if assert.Len(t, deliveries, 2) {
+		assert.Equal(t, 3, deliveries[0].Attempt)
+		assert.Equal(t, 2, deliveries[1].Attempt)
+	}
+
+	deliveries, err = log.GetDeliveries(context.Background(), "unknown")
+	assert.NoError(t, err)
+	assert.Empty(t, deliveries)
+}
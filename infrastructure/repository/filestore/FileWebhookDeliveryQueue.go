@@ -0,0 +1,143 @@
+package filestore
+
+import (
+	"authz/domain"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+)
+
+// FileWebhookDeliveryQueue keeps pending webhook deliveries as one file per delivery, so that they survive restarts
+type FileWebhookDeliveryQueue struct {
+	dir directory
+}
+
+// pendingDeliveryDocument is the serialized form of a domain.PendingWebhookDelivery
+type pendingDeliveryDocument struct {
+	WebhookID   string               `json:"webhookId"`
+	OrgID       string               `json:"orgId"`
+	Event       licenseEventDocument `json:"event"`
+	Attempts    int                  `json:"attempts"`
+	NextAttempt time.Time            `json:"nextAttempt"`
+}
+
+// licenseEventDocument is the serialized form of a domain.LicenseEvent
+type licenseEventDocument struct {
+	Type           string `json:"type"`
+	OrgID          string `json:"orgId"`
+	ServiceID      string `json:"serviceId"`
+	SubjectID      string `json:"subjectId,omitempty"`
+	SeatsTotal     int    `json:"seatsTotal"`
+	SeatsAvailable int    `json:"seatsAvailable"`
+	// PreviousSeatsTotal is only set for LicenseUpdated events
+	PreviousSeatsTotal int `json:"previousSeatsTotal,omitempty"`
+}
+
+func newLicenseEventDocument(evt domain.LicenseEvent) licenseEventDocument {
+	return licenseEventDocument{
+		Type:               string(evt.Type),
+		OrgID:              evt.OrgID,
+		ServiceID:          evt.ServiceID,
+		SubjectID:          string(evt.SubjectID),
+		SeatsTotal:         evt.SeatsTotal,
+		SeatsAvailable:     evt.SeatsAvailable,
+		PreviousSeatsTotal: evt.PreviousSeatsTotal,
+	}
+}
+
+func (d licenseEventDocument) licenseEvent() domain.LicenseEvent {
+	return domain.LicenseEvent{
+		Type:               domain.LicenseEventType(d.Type),
+		OrgID:              d.OrgID,
+		ServiceID:          d.ServiceID,
+		SubjectID:          domain.SubjectID(d.SubjectID),
+		SeatsTotal:         d.SeatsTotal,
+		SeatsAvailable:     d.SeatsAvailable,
+		PreviousSeatsTotal: d.PreviousSeatsTotal,
+	}
+}
+
+// NewFileWebhookDeliveryQueue constructs a new FileWebhookDeliveryQueue keeping its files in the webhook-deliveries directory below stateDir
+func NewFileWebhookDeliveryQueue(stateDir string) (*FileWebhookDeliveryQueue, error) {
+	dir, err := openDirectory(stateDir, "webhook-deliveries")
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileWebhookDeliveryQueue{dir: dir}, nil
+}
+
+// AddPendingDeliveries stores new pending deliveries
+func (q *FileWebhookDeliveryQueue) AddPendingDeliveries(ctx context.Context, deliveries []domain.PendingWebhookDelivery) error {
+	for _, delivery := range deliveries {
+		if err := q.UpdatePendingDelivery(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetDueDeliveries retrieves up to limit pending deliveries that are due at the given time, ordered by ID
+func (q *FileWebhookDeliveryQueue) GetDueDeliveries(_ context.Context, now time.Time, limit int) ([]domain.PendingWebhookDelivery, error) {
+	ids, err := q.dir.ids()
+	if err != nil {
+		return nil, err
+	}
+
+	due := make([]domain.PendingWebhookDelivery, 0)
+	for _, id := range ids {
+		if len(due) >= limit {
+			break
+		}
+
+		var doc pendingDeliveryDocument
+		if err := q.dir.get(id, &doc); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue // completed concurrently
+			}
+			return nil, err
+		}
+
+		delivery := domain.PendingWebhookDelivery{
+			ID:          id,
+			WebhookID:   doc.WebhookID,
+			OrgID:       doc.OrgID,
+			Event:       doc.Event.licenseEvent(),
+			Attempts:    doc.Attempts,
+			NextAttempt: doc.NextAttempt,
+		}
+		if delivery.Due(now) {
+			due = append(due, delivery)
+		}
+	}
+
+	return due, nil
+}
+
+// UpdatePendingDelivery replaces a pending delivery after a failed attempt
+func (q *FileWebhookDeliveryQueue) UpdatePendingDelivery(_ context.Context, delivery domain.PendingWebhookDelivery) error {
+	err := q.dir.put(delivery.ID, pendingDeliveryDocument{
+		WebhookID:   delivery.WebhookID,
+		OrgID:       delivery.OrgID,
+		Event:       newLicenseEventDocument(delivery.Event),
+		Attempts:    delivery.Attempts,
+		NextAttempt: delivery.NextAttempt,
+	})
+	if err != nil {
+		return fmt.Errorf("error storing pending delivery %s to webhook %s: %w", delivery.ID, delivery.WebhookID, err)
+	}
+
+	return nil
+}
+
+// RemovePendingDelivery deletes a pending delivery that succeeded or was given up. Unknown IDs are ignored.
+func (q *FileWebhookDeliveryQueue) RemovePendingDelivery(_ context.Context, id string) error {
+	if err := q.dir.remove(id); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
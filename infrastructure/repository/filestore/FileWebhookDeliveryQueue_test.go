@@ -0,0 +1,55 @@
+package filestore
+
+import (
+	"authz/domain"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingDeliveriesAreDueInOrderUntilRemoved(t *testing.T) {
+	queue, err := NewFileWebhookDeliveryQueue(t.TempDir())
+	assert.NoError(t, err)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	evt := domain.LicenseEvent{Type: domain.SeatAssigned, OrgID: "o1", ServiceID: "smarts", SubjectID: "u1", SeatsTotal: 5, SeatsAvailable: 4}
+	first := domain.PendingWebhookDelivery{ID: domain.NewPendingWebhookDeliveryID(now), WebhookID: "w1", OrgID: "o1", Event: evt}
+	second := domain.PendingWebhookDelivery{ID: domain.NewPendingWebhookDeliveryID(now.Add(time.Second)), WebhookID: "w2", OrgID: "o1", Event: evt}
+	assert.NoError(t, queue.AddPendingDeliveries(context.Background(), []domain.PendingWebhookDelivery{second, first}))
+
+	due, err := queue.GetDueDeliveries(context.Background(), now, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PendingWebhookDelivery{first, second}, due)
+
+	due, err = queue.GetDueDeliveries(context.Background(), now, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PendingWebhookDelivery{first}, due)
+
+	assert.NoError(t, queue.RemovePendingDelivery(context.Background(), first.ID))
+	assert.NoError(t, queue.RemovePendingDelivery(context.Background(), first.ID))
+
+	due, err = queue.GetDueDeliveries(context.Background(), now, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PendingWebhookDelivery{second}, due)
+}
+
+func TestRescheduledDeliveriesAreNotDueBeforeTheirNextAttempt(t *testing.T) {
+	queue, err := NewFileWebhookDeliveryQueue(t.TempDir())
+	assert.NoError(t, err)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	delivery := domain.PendingWebhookDelivery{ID: domain.NewPendingWebhookDeliveryID(now), WebhookID: "w1", OrgID: "o1", Event: domain.LicenseEvent{Type: domain.LicenseEntitled, OrgID: "o1", ServiceID: "smarts"}}
+	assert.NoError(t, queue.AddPendingDeliveries(context.Background(), []domain.PendingWebhookDelivery{delivery}))
+
+	delivery.Attempts = 1
+	delivery.NextAttempt = now.Add(time.Minute)
+	assert.NoError(t, queue.UpdatePendingDelivery(context.Background(), delivery))
+
+	due, err := queue.GetDueDeliveries(context.Background(), now, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, due)
+
+	due, err = queue.GetDueDeliveries(context.Background(), now.Add(time.Minute), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PendingWebhookDelivery{delivery}, due)
+}
@@ -0,0 +1,156 @@
+package filestore
+
+import (
+	"authz/domain"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+)
+
+// FileWebhookRepository keeps webhooks as one file per webhook in a directory per organization, so that the webhooks of an org are read without reading those of other orgs. Each change writes a single file, so replicas sharing the directory do not overwrite each other's changes.
+// Secrets are encrypted with AES-GCM, they are only readable with the secret key.
+type FileWebhookRepository struct {
+	dir  directory
+	aead cipher.AEAD
+}
+
+// webhookDocument is the serialized form of a domain.Webhook
+type webhookDocument struct {
+	ID        string `json:"id"`
+	OrgID     string `json:"orgId"`
+	ServiceID string `json:"serviceId,omitempty"`
+	URL       string `json:"url"`
+	// EncryptedSecret is the base64 encoded nonce followed by the sealed secret
+	EncryptedSecret string `json:"encryptedSecret"`
+}
+
+// NewFileWebhookRepository constructs a new FileWebhookRepository keeping its files in the webhooks directory below stateDir. The secret key must have 32 bytes.
+func NewFileWebhookRepository(stateDir string, secretKey []byte) (*FileWebhookRepository, error) {
+	if len(secretKey) != 32 {
+		return nil, fmt.Errorf("webhook secret key must have 32 bytes, has %d", len(secretKey))
+	}
+
+	block, err := aes.NewCipher(secretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := openDirectory(stateDir, "webhooks")
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileWebhookRepository{dir: dir, aead: aead}, nil
+}
+
+// AddWebhook stores a new webhook
+func (r *FileWebhookRepository) AddWebhook(_ context.Context, webhook domain.Webhook) error {
+	orgDir, err := r.dir.sub(webhook.OrgID)
+	if err != nil {
+		return err
+	}
+
+	secret, err := r.seal(webhook)
+	if err != nil {
+		return err
+	}
+
+	return orgDir.put(webhook.ID, webhookDocument{
+		ID:              webhook.ID,
+		OrgID:           webhook.OrgID,
+		ServiceID:       webhook.ServiceID,
+		URL:             webhook.URL,
+		EncryptedSecret: secret,
+	})
+}
+
+// GetWebhooks retrieves the webhooks of an organization, ordered by ID
+func (r *FileWebhookRepository) GetWebhooks(_ context.Context, orgID string) ([]domain.Webhook, error) {
+	orgDir, err := r.dir.sub(orgID)
+	if err != nil {
+		return []domain.Webhook{}, nil
+	}
+
+	ids, err := orgDir.ids()
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]domain.Webhook, 0, len(ids))
+	for _, id := range ids {
+		var doc webhookDocument
+		if err := orgDir.get(id, &doc); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue // removed concurrently
+			}
+			return nil, err
+		}
+
+		// The location of the file determines the webhook, so that the secret is only readable where it was written
+		webhook := domain.Webhook{ID: id, OrgID: orgID, ServiceID: doc.ServiceID, URL: doc.URL}
+		if webhook.Secret, err = r.open(webhook, doc.EncryptedSecret); err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+// RemoveWebhook deletes a webhook of an organization
+func (r *FileWebhookRepository) RemoveWebhook(_ context.Context, orgID string, webhookID string) error {
+	orgDir, err := r.dir.sub(orgID)
+	if err != nil {
+		return domain.ErrWebhookNotFound
+	}
+
+	if err := orgDir.remove(webhookID); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return domain.ErrWebhookNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// seal encrypts the secret of a webhook. The org and webhook IDs are authenticated with it, so that a sealed secret cannot be moved to another webhook.
+func (r *FileWebhookRepository) seal(webhook domain.Webhook) (string, error) {
+	nonce := make([]byte, r.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := r.aead.Seal(nonce, nonce, []byte(webhook.Secret), secretAssociatedData(webhook))
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// open decrypts the secret of a webhook
+func (r *FileWebhookRepository) open(webhook domain.Webhook, encrypted string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil || len(sealed) < r.aead.NonceSize() {
+		return "", fmt.Errorf("invalid secret of webhook %s of org %s", webhook.ID, webhook.OrgID)
+	}
+
+	nonce, ciphertext := sealed[:r.aead.NonceSize()], sealed[r.aead.NonceSize():]
+	secret, err := r.aead.Open(nil, nonce, ciphertext, secretAssociatedData(webhook))
+	if err != nil {
+		return "", fmt.Errorf("cannot decrypt secret of webhook %s of org %s, was the secret key changed? %w", webhook.ID, webhook.OrgID, err)
+	}
+
+	return string(secret), nil
+}
+
+func secretAssociatedData(webhook domain.Webhook) []byte {
+	return []byte(webhook.OrgID + "/" + webhook.ID)
+}
@@ -0,0 +1,63 @@
+package filestore
+
+import (
+	"authz/domain"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testKey = bytes.Repeat([]byte{7}, 32)
+
+func TestWebhookSecretsAreEncrypted(t *testing.T) {
+	dir := t.TempDir()
+	repo, err := NewFileWebhookRepository(dir, testKey)
+	assert.NoError(t, err)
+	webhook := domain.Webhook{ID: "w1", OrgID: "o1", URL: "https://example.com/hook", Secret: "s3cr3t-s3cr3t-s3cr3t"}
+
+	assert.NoError(t, repo.AddWebhook(context.Background(), webhook))
+
+	content, err := os.ReadFile(filepath.Join(dir, "webhooks", "o1", "w1.json"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), webhook.URL)
+	assert.NotContains(t, string(content), webhook.Secret)
+}
+
+func TestWebhookSecretsCannotBeReadWithAnotherKey(t *testing.T) {
+	dir := t.TempDir()
+	repo, err := NewFileWebhookRepository(dir, testKey)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.AddWebhook(context.Background(), domain.Webhook{ID: "w1", OrgID: "o1", URL: "https://example.com/hook", Secret: "s3cr3t-s3cr3t-s3cr3t"}))
+
+	other, err := NewFileWebhookRepository(dir, bytes.Repeat([]byte{8}, 32))
+	assert.NoError(t, err)
+	_, err = other.GetWebhooks(context.Background(), "o1")
+
+	assert.Error(t, err)
+}
+
+func TestWebhookSecretsCannotBeMovedToAnotherWebhook(t *testing.T) {
+	dir := t.TempDir()
+	repo, err := NewFileWebhookRepository(dir, testKey)
+	assert.NoError(t, err)
+	assert.NoError(t, repo.AddWebhook(context.Background(), domain.Webhook{ID: "w1", OrgID: "o1", URL: "https://example.com/hook", Secret: "s3cr3t-s3cr3t-s3cr3t"}))
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "webhooks", "o2"), 0o700))
+	content, err := os.ReadFile(filepath.Join(dir, "webhooks", "o1", "w1.json"))
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "webhooks", "o2", "w1.json"), content, 0o600))
+
+	_, err = repo.GetWebhooks(context.Background(), "o2")
+
+	assert.Error(t, err)
+}
+
+func TestWebhookSecretKeyMustHave32Bytes(t *testing.T) {
+	_, err := NewFileWebhookRepository(t.TempDir(), []byte("too short"))
+
+	assert.Error(t, err)
+}
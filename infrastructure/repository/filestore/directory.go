@@ -0,0 +1,138 @@
+// Package filestore keeps documents that do not belong into the relationships of the authorization store as JSON files below a state directory. Replicas of the service share the state directory, e.g. on a shared volume.
+package filestore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// extension of document files, temporary files written before being renamed have none
+const extension = ".json"
+
+// directory stores JSON documents as files of a single directory, one file per document named after its ID
+type directory struct {
+	path string
+}
+
+// openDirectory opens the directory below root with the given relative path, which is created if it does not exist
+func openDirectory(root string, path ...string) (directory, error) {
+	if root == "" {
+		return directory{}, errors.New("no state directory configured")
+	}
+
+	dir := filepath.Join(append([]string{root}, path...)...)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return directory{}, err
+	}
+
+	return directory{path: dir}, nil
+}
+
+// sub returns the subdirectory with the given name without creating it, so that reading from it does not leave empty directories behind
+func (d directory) sub(name string) (directory, error) {
+	if !validName(name) {
+		return directory{}, fmt.Errorf("invalid directory name %q: %w", name, fs.ErrNotExist)
+	}
+
+	return directory{path: filepath.Join(d.path, name)}, nil
+}
+
+// put writes the document with the given ID. The file is replaced atomically, so readers never see a partially written document.
+func (d directory) put(id string, doc interface{}) error {
+	if !validName(id) {
+		return fmt.Errorf("invalid document ID %q", id)
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(d.path, 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(d.path, "."+id+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // fails once renamed
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), d.file(id))
+}
+
+// get reads the document with the given ID into doc. It fails with an error matching fs.ErrNotExist if there is no such document.
+func (d directory) get(id string, doc interface{}) error {
+	if !validName(id) {
+		return fmt.Errorf("invalid document ID %q: %w", id, fs.ErrNotExist)
+	}
+
+	data, err := os.ReadFile(d.file(id))
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, doc); err != nil {
+		return fmt.Errorf("invalid document %s: %w", d.file(id), err)
+	}
+
+	return nil
+}
+
+// remove deletes the document with the given ID. It fails with an error matching fs.ErrNotExist if there is no such document.
+func (d directory) remove(id string) error {
+	if !validName(id) {
+		return fmt.Errorf("invalid document ID %q: %w", id, fs.ErrNotExist)
+	}
+
+	return os.Remove(d.file(id))
+}
+
+// ids lists the IDs of all documents in ascending order, none if the directory does not exist
+func (d directory) ids() ([]string, error) {
+	entries, err := os.ReadDir(d.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() && validName(name) && strings.HasSuffix(name, extension) {
+			ids = append(ids, strings.TrimSuffix(name, extension))
+		}
+	}
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+func (d directory) file(id string) string {
+	return filepath.Join(d.path, id+extension)
+}
+
+// validName returns true if name can be used as a file name without leaving the directory or being mistaken for a temporary file
+func validName(name string) bool {
+	return name != "" && !strings.HasPrefix(name, ".") && !strings.ContainsAny(name, `/\`) && filepath.Base(name) == name
+}
@@ -0,0 +1,685 @@
+// Package memory contains an in-memory implementation of the store contracts that mirrors the semantics of the SpiceDB schema
+package memory
+
+import (
+	"authz/domain"
+	"bufio"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+const (
+	orgType          = "org"
+	serviceType      = "service"
+	subjectType      = "user"
+	licenseSeatType  = "license_seats"
+	licenseType      = "license"
+	licenseVersion   = "version"
+	licenseMax       = "max"
+	licenseStart     = "start"
+	licenseEnd       = "end"
+	licenseReclaim   = "reclaim"
+	reclaimPolicy    = "reclaim_policy"
+	reclaimDisabled  = "disabled"
+	timestampType    = "timestamp"
+	seatType         = "seat"
+	assignedAtRel    = "assigned_at"
+	seatsRelation    = "seats"
+	assignedRelation = "assigned"
+	memberRelation   = "member"
+	disabledRelation = "disabled"
+	adminRelation    = "admin"
+	managerRelation  = "license_manager"
+	licenseAdminRel  = "license_admin"
+	accessPermission = "access"
+)
+
+// relationship is a single tuple in the form resourceType:resourceID#relation@subjectType:subjectID
+type relationship struct {
+	ResourceType string
+	ResourceID   string
+	Relation     string
+	SubjectType  string
+	SubjectID    string
+}
+
+// InMemoryAccessRepository is a process-local store implementing AccessRepository, SeatLicenseRepository and OrganizationRepository. It is meant for local development and tests.
+type InMemoryAccessRepository struct {
+	mu            sync.RWMutex
+	relationships map[relationship]struct{}
+	outbox        *InMemoryOutbox
+}
+
+// NewInMemoryAccessRepository constructs a new, empty InMemoryAccessRepository
+func NewInMemoryAccessRepository() *InMemoryAccessRepository {
+	return &InMemoryAccessRepository{relationships: make(map[relationship]struct{})}
+}
+
+// LoadRelationships seeds the store with relationships in the SpiceDB bootstrap format (one resourceType:resourceID#relation@subjectType:subjectID per line, // comments allowed)
+func (m *InMemoryAccessRepository) LoadRelationships(relationships string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	scanner := bufio.NewScanner(strings.NewReader(relationships))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		rel, err := parseRelationship(line)
+		if err != nil {
+			return err
+		}
+
+		m.relationships[rel] = struct{}{}
+	}
+
+	return scanner.Err()
+}
+
+// CheckAccess - verify permission with subject type "user"
+func (m *InMemoryAccessRepository) CheckAccess(ctx context.Context, subjectID domain.SubjectID, operation string, resource domain.Resource) (domain.AccessDecision, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if !m.hasPermission(string(subjectID), operation, resource.Type, resource.ID) {
+		return false, nil
+	}
+
+	if resource.Type == licenseType && operation == accessPermission {
+		// Mirrors the access_in_term permission checked by SpiceDB: licenses deny access outside of their term, but can be managed
+		outOfTerm, err := m.isOutOfTerm(resource.ID, time.Now())
+		return domain.AccessDecision(!outOfTerm), err
+	}
+
+	return true, nil
+}
+
+// isOutOfTerm mirrors the caveated out_of_term relation of licenses, which holds if now is before the start or at or after the end of the term. Callers must hold the lock.
+func (m *InMemoryAccessRepository) isOutOfTerm(licenseID string, now time.Time) (bool, error) {
+	var license domain.License
+	for _, rel := range m.filter(licenseType, licenseID, "", timestampType, "") {
+		if err := setLicenseTerm(&license, rel.Relation, rel.SubjectID); err != nil {
+			return false, err
+		}
+	}
+
+	return !license.IsActiveAt(now), nil
+}
+
+// ModifySeats atomically persists changes to seat assignments for a license
+func (m *InMemoryAccessRepository) ModifySeats(ctx context.Context, assignedSubjectIDs []domain.SubjectID, removedSubjectIDs []domain.SubjectID, license *domain.License, orgID string, svc domain.Service) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	seatsID := fmt.Sprintf("%s/%s", orgID, svc.ID)
+	assignedCount := license.InUse
+	touched := make(map[domain.SubjectID]bool, len(assignedSubjectIDs)+len(removedSubjectIDs))
+
+	for _, subj := range assignedSubjectIDs {
+		if touched[subj] {
+			return domain.NewErrInvalidRequest(fmt.Sprintf("subject %s can only be modified once per request", subj))
+		}
+		touched[subj] = true
+
+		if m.has(orgType, orgID, disabledRelation, subjectType, string(subj)) || !m.has(orgType, orgID, memberRelation, subjectType, string(subj)) {
+			return domain.ErrConflict
+		}
+		if m.has(licenseSeatType, seatsID, assignedRelation, subjectType, string(subj)) {
+			return domain.ErrConflict
+		}
+
+		assignedCount++
+	}
+
+	for _, subj := range removedSubjectIDs {
+		if touched[subj] {
+			return domain.NewErrInvalidRequest(fmt.Sprintf("subject %s can only be modified once per request", subj))
+		}
+		touched[subj] = true
+
+		if !m.has(licenseSeatType, seatsID, assignedRelation, subjectType, string(subj)) {
+			return domain.ErrConflict
+		}
+
+		assignedCount--
+	}
+
+	licenseID := fmt.Sprintf("%s/%s", license.OrgID, license.ServiceID)
+	oldVersion := fmt.Sprintf("%s/%d", license.Version, license.InUse)
+	if !m.has(licenseType, licenseID, licenseVersion, licenseVersion, oldVersion) {
+		return domain.ErrConflict
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	for _, subj := range assignedSubjectIDs {
+		m.add(licenseSeatType, seatsID, assignedRelation, subjectType, string(subj))
+		m.add(seatType, fmt.Sprintf("%s/%s", seatsID, subj), assignedAtRel, timestampType, now)
+	}
+	for _, subj := range removedSubjectIDs {
+		m.remove(licenseSeatType, seatsID, assignedRelation, subjectType, string(subj))
+		m.removeAssignedAt(seatsID, subj)
+	}
+
+	if assignedCount != license.InUse {
+		m.remove(licenseType, licenseID, licenseVersion, licenseVersion, oldVersion)
+		m.add(licenseType, licenseID, licenseVersion, licenseVersion, fmt.Sprintf("%s/%d", license.Version, assignedCount))
+	}
+
+	updated := *license
+	updated.InUse = assignedCount
+	m.recordOutboxEvents(domain.NewSeatEvents(&updated, assignedSubjectIDs, removedSubjectIDs))
+
+	glog.Infof("Successfully assigned %s / unassigned %s seats on license %s for org %s. Current seats used: %d of %d", assignedSubjectIDs, removedSubjectIDs, license.ServiceID, license.OrgID, assignedCount, license.MaxSeats)
+
+	return nil
+}
+
+// GetLicense - Get the current license information
+func (m *InMemoryAccessRepository) GetLicense(ctx context.Context, orgID string, serviceID string) (*domain.License, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.getLicense(orgID, serviceID)
+}
+
+// GetLicenses retrieves all licenses applied to the given organization, ordered by service ID
+func (m *InMemoryAccessRepository) GetLicenses(ctx context.Context, orgID string) ([]*domain.License, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var serviceIDs []string
+	for _, rel := range m.filter(licenseType, "", orgType, orgType, orgID) {
+		serviceIDs = append(serviceIDs, strings.TrimPrefix(rel.ResourceID, orgID+"/"))
+	}
+	sort.Strings(serviceIDs)
+
+	licenses := make([]*domain.License, 0, len(serviceIDs))
+	for _, serviceID := range serviceIDs {
+		license, err := m.getLicense(orgID, serviceID)
+		if err != nil {
+			return nil, err
+		}
+
+		licenses = append(licenses, license)
+	}
+
+	return licenses, nil
+}
+
+// getLicense reads a license from its relationships. Callers must hold the lock.
+func (m *InMemoryAccessRepository) getLicense(orgID string, serviceID string) (*domain.License, error) {
+	var license domain.License
+	licenseID := fmt.Sprintf("%s/%s", orgID, serviceID)
+
+	for _, rel := range m.filter(licenseType, licenseID, "", "", "") {
+		switch rel.Relation {
+		case licenseMax:
+			maxSeats, err := strconv.Atoi(rel.SubjectID)
+			if err != nil {
+				return nil, err
+			}
+			license.MaxSeats = maxSeats
+		case licenseVersion:
+			versionStrArr := strings.Split(rel.SubjectID, "/")
+			if len(versionStrArr) != 2 {
+				return nil, fmt.Errorf("invalid license version %s", rel.SubjectID)
+			}
+			inUse, err := strconv.Atoi(versionStrArr[1])
+			if err != nil {
+				return nil, err
+			}
+			license.InUse = inUse
+			license.Version = versionStrArr[0]
+		case licenseStart, licenseEnd:
+			if err := setLicenseTerm(&license, rel.Relation, rel.SubjectID); err != nil {
+				return nil, err
+			}
+		case licenseReclaim:
+			license.ReclaimDisabledSeats = true
+		}
+		license.OrgID = orgID
+		license.ServiceID = serviceID
+	}
+
+	return &license, nil
+}
+
+// GetSeatAssignments retrieves the seats the given subject holds in licenses of the given organization, ordered by service ID
+func (m *InMemoryAccessRepository) GetSeatAssignments(ctx context.Context, orgID string, subjectID domain.SubjectID) ([]domain.SeatAssignment, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var serviceIDs []string
+	for _, rel := range m.filter(licenseType, "", orgType, orgType, orgID) {
+		if m.hasPermission(string(subjectID), accessPermission, licenseType, rel.ResourceID) {
+			serviceIDs = append(serviceIDs, strings.TrimPrefix(rel.ResourceID, orgID+"/"))
+		}
+	}
+	sort.Strings(serviceIDs)
+
+	assignments := make([]domain.SeatAssignment, 0, len(serviceIDs))
+	for _, serviceID := range serviceIDs {
+		assignment := domain.SeatAssignment{OrgID: orgID, ServiceID: serviceID, SubjectID: subjectID}
+
+		for _, rel := range m.filter(seatType, fmt.Sprintf("%s/%s/%s", orgID, serviceID, subjectID), assignedAtRel, timestampType, "") {
+			seconds, err := strconv.ParseInt(rel.SubjectID, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timestamp %s", rel.SubjectID)
+			}
+			assignment.AssignedAt = time.Unix(seconds, 0).UTC()
+		}
+
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments, nil
+}
+
+// HasAnyLicense returns true if an org has at least one license applied, false otherwise for orgs without licenses or unknown orgs
+func (m *InMemoryAccessRepository) HasAnyLicense(ctx context.Context, orgID string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return len(m.filter(licenseType, "", orgType, orgType, orgID)) > 0, nil
+}
+
+// GetAssignable returns assignable seats for a given organization ID and service ID (which are not already assigned)
+func (m *InMemoryAccessRepository) GetAssignable(ctx context.Context, orgID string, serviceID string) ([]domain.SubjectID, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.lookupSubjects("assignable", fmt.Sprintf("%s/%s", orgID, serviceID)), nil
+}
+
+// GetAssigned returns assigned seats for a given organization ID and service ID
+func (m *InMemoryAccessRepository) GetAssigned(ctx context.Context, orgID string, serviceID string) ([]domain.SubjectID, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.lookupSubjects(accessPermission, fmt.Sprintf("%s/%s", orgID, serviceID)), nil
+}
+
+// GetReclaimable returns the disabled subjects still assigned seats for a given organization ID and service ID
+func (m *InMemoryAccessRepository) GetReclaimable(ctx context.Context, orgID string, serviceID string) ([]domain.SubjectID, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.lookupSubjects("reclaimable", fmt.Sprintf("%s/%s", orgID, serviceID)), nil
+}
+
+// ApplyLicense stores the given license associated with its service and organization
+func (m *InMemoryAccessRepository) ApplyLicense(ctx context.Context, license *domain.License) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	licenseID := fmt.Sprintf("%s/%s", license.OrgID, license.ServiceID)
+	if len(m.filter(licenseType, licenseID, "", "", "")) > 0 {
+		return domain.ErrConflict
+	}
+
+	m.add(licenseType, licenseID, licenseMax, licenseMax, strconv.Itoa(license.MaxSeats))
+	m.add(licenseType, licenseID, seatsRelation, licenseSeatType, licenseID)
+	m.add(licenseType, licenseID, licenseVersion, licenseVersion, fmt.Sprintf("%s/%d", license.Version, license.InUse))
+	m.add(licenseType, licenseID, orgType, orgType, license.OrgID)
+	if !license.StartDate.IsZero() {
+		m.add(licenseType, licenseID, licenseStart, timestampType, strconv.FormatInt(license.StartDate.Unix(), 10))
+	}
+	if !license.EndDate.IsZero() {
+		m.add(licenseType, licenseID, licenseEnd, timestampType, strconv.FormatInt(license.EndDate.Unix(), 10))
+	}
+
+	m.recordOutboxEvents([]domain.LicenseEvent{domain.NewLicenseEntitledEvent(license)})
+
+	return nil
+}
+
+// UpdateLicense atomically replaces the seat limit and version of a stored license
+func (m *InMemoryAccessRepository) UpdateLicense(ctx context.Context, current *domain.License, updated *domain.License) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	licenseID := fmt.Sprintf("%s/%s", current.OrgID, current.ServiceID)
+	oldMax := strconv.Itoa(current.MaxSeats)
+	oldVersion := fmt.Sprintf("%s/%d", current.Version, current.InUse)
+	if !m.has(licenseType, licenseID, licenseMax, licenseMax, oldMax) || !m.has(licenseType, licenseID, licenseVersion, licenseVersion, oldVersion) {
+		return domain.ErrConflict
+	}
+
+	m.remove(licenseType, licenseID, licenseMax, licenseMax, oldMax)
+	m.remove(licenseType, licenseID, licenseVersion, licenseVersion, oldVersion)
+	m.add(licenseType, licenseID, licenseMax, licenseMax, strconv.Itoa(updated.MaxSeats))
+	m.add(licenseType, licenseID, licenseVersion, licenseVersion, fmt.Sprintf("%s/%d", updated.Version, updated.InUse))
+
+	m.recordOutboxEvents([]domain.LicenseEvent{domain.NewLicenseUpdatedEvent(current, updated)})
+
+	return nil
+}
+
+// RevokeLicense atomically removes a license together with all its seat assignments
+func (m *InMemoryAccessRepository) RevokeLicense(ctx context.Context, orgID string, serviceID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	licenseID := fmt.Sprintf("%s/%s", orgID, serviceID)
+	licenseRels := m.filter(licenseType, licenseID, "", "", "")
+	if len(licenseRels) == 0 {
+		return domain.ErrLicenseNotFound
+	}
+
+	maxSeats := 0
+	for _, rel := range licenseRels {
+		delete(m.relationships, rel)
+		if rel.Relation == licenseMax {
+			maxSeats, _ = strconv.Atoi(rel.SubjectID)
+		}
+	}
+	for _, rel := range m.filter(licenseSeatType, licenseID, "", "", "") {
+		delete(m.relationships, rel)
+		m.removeAssignedAt(licenseID, domain.SubjectID(rel.SubjectID))
+	}
+
+	m.recordOutboxEvents([]domain.LicenseEvent{domain.NewLicenseRevokedEvent(orgID, serviceID, maxSeats)})
+
+	return nil
+}
+
+// SetSeatReclamation enables or disables the automatic unassignment of seats held by disabled subjects for a license
+func (m *InMemoryAccessRepository) SetSeatReclamation(ctx context.Context, orgID string, serviceID string, enabled bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	licenseID := fmt.Sprintf("%s/%s", orgID, serviceID)
+	if len(m.filter(licenseType, licenseID, orgType, orgType, orgID)) == 0 {
+		return domain.ErrLicenseNotFound
+	}
+
+	if enabled {
+		m.add(licenseType, licenseID, licenseReclaim, reclaimPolicy, reclaimDisabled)
+	} else {
+		m.remove(licenseType, licenseID, licenseReclaim, reclaimPolicy, reclaimDisabled)
+	}
+
+	return nil
+}
+
+// GetLicensesReclaimingSeats retrieves the licenses of all organizations that have seat reclamation enabled, ordered by organization and service ID
+func (m *InMemoryAccessRepository) GetLicensesReclaimingSeats(ctx context.Context) ([]*domain.License, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var licenseIDs []string
+	for _, rel := range m.filter(licenseType, "", licenseReclaim, reclaimPolicy, reclaimDisabled) {
+		licenseIDs = append(licenseIDs, rel.ResourceID)
+	}
+	sort.Strings(licenseIDs)
+
+	licenses := make([]*domain.License, 0, len(licenseIDs))
+	for _, licenseID := range licenseIDs {
+		orgID, serviceID, _ := strings.Cut(licenseID, "/")
+		license, err := m.getLicense(orgID, serviceID)
+		if err != nil {
+			return nil, err
+		}
+
+		licenses = append(licenses, license)
+	}
+
+	return licenses, nil
+}
+
+// AddLicenseAdmin allows the given subject to manage the license of an organization for a service
+func (m *InMemoryAccessRepository) AddLicenseAdmin(ctx context.Context, orgID string, serviceID string, subjectID domain.SubjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	licenseID := fmt.Sprintf("%s/%s", orgID, serviceID)
+	if len(m.filter(licenseType, licenseID, licenseVersion, "", "")) == 0 {
+		return domain.ErrLicenseNotFound
+	}
+
+	m.add(licenseType, licenseID, licenseAdminRel, subjectType, string(subjectID))
+	return nil
+}
+
+// RemoveLicenseAdmin revokes the permission of the given subject to manage the license of an organization for a service
+func (m *InMemoryAccessRepository) RemoveLicenseAdmin(ctx context.Context, orgID string, serviceID string, subjectID domain.SubjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(licenseType, fmt.Sprintf("%s/%s", orgID, serviceID), licenseAdminRel, subjectType, string(subjectID))
+	return nil
+}
+
+// AddSubject stores a subject associated with an organization. If a subject is already found, it returns an error.
+func (m *InMemoryAccessRepository) AddSubject(ctx context.Context, orgID string, subject domain.Subject) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.has(orgType, orgID, memberRelation, subjectType, string(subject.SubjectID)) {
+		return domain.ErrSubjectAlreadyExists
+	}
+
+	m.add(orgType, orgID, memberRelation, subjectType, string(subject.SubjectID))
+	if !subject.Enabled { //conditionally add tombstone
+		m.add(orgType, orgID, disabledRelation, subjectType, string(subject.SubjectID))
+	}
+
+	return nil
+}
+
+// UpsertSubject stores a subject associated with an organization. If a subject is found, it gets updated. If it is not found, it gets created.
+func (m *InMemoryAccessRepository) UpsertSubject(ctx context.Context, orgID string, subject domain.Subject) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.add(orgType, orgID, memberRelation, subjectType, string(subject.SubjectID))
+	if subject.Enabled {
+		m.remove(orgType, orgID, disabledRelation, subjectType, string(subject.SubjectID))
+	} else {
+		m.add(orgType, orgID, disabledRelation, subjectType, string(subject.SubjectID))
+	}
+
+	return nil
+}
+
+// RemoveSubject removes the membership of a subject in an organization, including its disabled and admin status
+func (m *InMemoryAccessRepository) RemoveSubject(ctx context.Context, orgID string, subjectID domain.SubjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, relation := range []string{memberRelation, disabledRelation, adminRelation} {
+		m.remove(orgType, orgID, relation, subjectType, string(subjectID))
+	}
+
+	return nil
+}
+
+// GetSubjectOrgs retrieves the IDs of the organizations the subject is a member of, ordered by ID
+func (m *InMemoryAccessRepository) GetSubjectOrgs(ctx context.Context, subjectID domain.SubjectID) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	orgIDs := make([]string, 0)
+	for _, rel := range m.filter(orgType, "", memberRelation, subjectType, string(subjectID)) {
+		orgIDs = append(orgIDs, rel.ResourceID)
+	}
+	sort.Strings(orgIDs)
+
+	return orgIDs, nil
+}
+
+// EnableOutbox makes ModifySeats, ApplyLicense, UpdateLicense and RevokeLicense record license events in the outbox together with their changes
+func (m *InMemoryAccessRepository) EnableOutbox(outbox *InMemoryOutbox) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.outbox = outbox
+}
+
+// Outbox returns the outbox license events are recorded in, nil unless EnableOutbox was called
+func (m *InMemoryAccessRepository) Outbox() *InMemoryOutbox {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.outbox
+}
+
+// recordOutboxEvents appends the given events to the outbox if it is enabled, callers must hold the write lock
+func (m *InMemoryAccessRepository) recordOutboxEvents(evts []domain.LicenseEvent) {
+	if m.outbox != nil {
+		m.outbox.record(evts)
+	}
+}
+
+// hasPermission evaluates the permissions defined in schema/spicedb_bootstrap.yaml, falling back to direct relations. Callers must hold the lock.
+func (m *InMemoryAccessRepository) hasPermission(subjectID string, permission string, resourceType string, resourceID string) bool {
+	switch {
+	case resourceType == licenseType && permission == accessPermission:
+		for _, seats := range m.filter(licenseType, resourceID, seatsRelation, licenseSeatType, "") {
+			if m.has(licenseSeatType, seats.SubjectID, assignedRelation, subjectType, subjectID) {
+				return true
+			}
+		}
+		return false
+	case resourceType == licenseType && permission == "assignable":
+		if m.hasPermission(subjectID, accessPermission, licenseType, resourceID) {
+			return false
+		}
+		for _, org := range m.filter(licenseType, resourceID, orgType, orgType, "") {
+			if m.hasPermission(subjectID, "enabled_users", orgType, org.SubjectID) {
+				return true
+			}
+		}
+		return false
+	case resourceType == licenseType && permission == "reclaimable":
+		if !m.hasPermission(subjectID, accessPermission, licenseType, resourceID) {
+			return false
+		}
+		for _, org := range m.filter(licenseType, resourceID, orgType, orgType, "") {
+			if m.has(orgType, org.SubjectID, disabledRelation, subjectType, subjectID) {
+				return true
+			}
+		}
+		return false
+	case resourceType == orgType && permission == "enabled_users":
+		return m.has(orgType, resourceID, memberRelation, subjectType, subjectID) &&
+			!m.has(orgType, resourceID, disabledRelation, subjectType, subjectID)
+	case resourceType == orgType && permission == "manage_license":
+		return m.has(orgType, resourceID, adminRelation, subjectType, subjectID)
+	case resourceType == licenseType && permission == "manage_license":
+		return m.has(licenseType, resourceID, licenseAdminRel, subjectType, subjectID)
+	case resourceType == serviceType && permission == "manage_license":
+		return m.has(serviceType, resourceID, managerRelation, subjectType, subjectID)
+	default:
+		return m.has(resourceType, resourceID, permission, subjectType, subjectID)
+	}
+}
+
+// lookupSubjects returns the sorted IDs of all users with the given permission on a license. Callers must hold the lock.
+func (m *InMemoryAccessRepository) lookupSubjects(permission string, licenseID string) []domain.SubjectID {
+	candidates := make(map[string]struct{})
+	for rel := range m.relationships {
+		if rel.SubjectType == subjectType {
+			candidates[rel.SubjectID] = struct{}{}
+		}
+	}
+
+	ids := make([]domain.SubjectID, 0)
+	for candidate := range candidates {
+		if m.hasPermission(candidate, permission, licenseType, licenseID) {
+			ids = append(ids, domain.SubjectID(candidate))
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// removeAssignedAt removes the recorded assignment time of a seat. Callers must hold the lock.
+func (m *InMemoryAccessRepository) removeAssignedAt(seatsID string, subj domain.SubjectID) {
+	for _, rel := range m.filter(seatType, fmt.Sprintf("%s/%s", seatsID, subj), assignedAtRel, "", "") {
+		delete(m.relationships, rel)
+	}
+}
+
+// filter returns all relationships matching the given fields, where empty strings match any value. Callers must hold the lock.
+func (m *InMemoryAccessRepository) filter(resourceType string, resourceID string, relation string, subjType string, subjectID string) []relationship {
+	var result []relationship
+	for rel := range m.relationships {
+		if matches(resourceType, rel.ResourceType) &&
+			matches(resourceID, rel.ResourceID) &&
+			matches(relation, rel.Relation) &&
+			matches(subjType, rel.SubjectType) &&
+			matches(subjectID, rel.SubjectID) {
+			result = append(result, rel)
+		}
+	}
+
+	return result
+}
+
+func (m *InMemoryAccessRepository) has(resourceType string, resourceID string, relation string, subjType string, subjectID string) bool {
+	_, ok := m.relationships[relationship{resourceType, resourceID, relation, subjType, subjectID}]
+	return ok
+}
+
+func (m *InMemoryAccessRepository) add(resourceType string, resourceID string, relation string, subjType string, subjectID string) {
+	m.relationships[relationship{resourceType, resourceID, relation, subjType, subjectID}] = struct{}{}
+}
+
+func (m *InMemoryAccessRepository) remove(resourceType string, resourceID string, relation string, subjType string, subjectID string) {
+	delete(m.relationships, relationship{resourceType, resourceID, relation, subjType, subjectID})
+}
+
+// setLicenseTerm sets the start or end date of the license from a timestamp in unix seconds
+func setLicenseTerm(license *domain.License, relation string, timestamp string) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid license %s %s", relation, timestamp)
+	}
+
+	switch relation {
+	case licenseStart:
+		license.StartDate = time.Unix(seconds, 0).UTC()
+	case licenseEnd:
+		license.EndDate = time.Unix(seconds, 0).UTC()
+	}
+	return nil
+}
+
+func matches(filter string, value string) bool {
+	return filter == "" || filter == value
+}
+
+func parseRelationship(line string) (relationship, error) {
+	resource, subject, ok := strings.Cut(line, "@")
+	if !ok {
+		return relationship{}, fmt.Errorf("invalid relationship %s: missing subject", line)
+	}
+
+	object, relation, ok := strings.Cut(resource, "#")
+	if !ok {
+		return relationship{}, fmt.Errorf("invalid relationship %s: missing relation", line)
+	}
+
+	resourceType, resourceID, ok := strings.Cut(object, ":")
+	if !ok {
+		return relationship{}, fmt.Errorf("invalid relationship %s: malformed resource", line)
+	}
+
+	subjType, subjectID, ok := strings.Cut(subject, ":")
+	if !ok {
+		return relationship{}, fmt.Errorf("invalid relationship %s: malformed subject", line)
+	}
+
+	return relationship{resourceType, resourceID, relation, subjType, subjectID}, nil
+}
@@ -0,0 +1,262 @@
+package memory
+
+import (
+	"authz/domain"
+	"authz/domain/contracts"
+	"authz/infrastructure/repository/contracttest"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckAccess(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	cases := []struct {
+		sub       domain.SubjectID
+		operation string
+		resource  domain.Resource
+		expected  domain.AccessDecision
+	}{
+		{sub: "u1", operation: "access", resource: domain.Resource{Type: "license", ID: "o1/smarts"}, expected: true},
+		{sub: "u1", operation: "access", resource: domain.Resource{Type: "license", ID: "o1/doesnotexist"}, expected: false},
+		{sub: "doesnotexist", operation: "access", resource: domain.Resource{Type: "license", ID: "o1/smarts"}, expected: false},
+		{sub: "u2", operation: "assignable", resource: domain.Resource{Type: "license", ID: "o1/smarts"}, expected: true},
+		{sub: "u4", operation: "assignable", resource: domain.Resource{Type: "license", ID: "o1/smarts"}, expected: false},
+		{sub: "u4", operation: "disabled", resource: domain.Resource{Type: "org", ID: "o1"}, expected: true},
+		{sub: "okay", operation: "manage_license", resource: domain.Resource{Type: "org", ID: "o1"}, expected: true},
+		{sub: "okay", operation: "manage_license", resource: domain.Resource{Type: "org", ID: "o2"}, expected: false},
+		{sub: "system", operation: "manage_license", resource: domain.Resource{Type: "service", ID: "smarts"}, expected: true},
+		{sub: "u1", operation: "manage_license", resource: domain.Resource{Type: "service", ID: "smarts"}, expected: false},
+	}
+
+	for _, testcase := range cases {
+		actual, err := repo.CheckAccess(context.Background(), testcase.sub, testcase.operation, testcase.resource)
+		assert.NoError(t, err)
+		assert.Equal(t, testcase.expected, actual, "Unexpected result for case (subject: %s, operation: %s, resource: [%s, %s])", testcase.sub, testcase.operation, testcase.resource.Type, testcase.resource.ID)
+	}
+}
+
+func TestCheckAccessOfExpiredLicenseOnlyDeniesAccess(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	expired := &domain.License{OrgID: "o1", ServiceID: "media", MaxSeats: 5, Version: "l", EndDate: time.Now().Add(-time.Hour).Truncate(time.Second)}
+	assert.NoError(t, repo.ApplyLicense(context.Background(), expired))
+	assert.NoError(t, repo.ModifySeats(context.Background(), []domain.SubjectID{"u2"}, nil, expired, "o1", domain.Service{ID: "media"}))
+	assert.NoError(t, repo.AddLicenseAdmin(context.Background(), "o1", "media", "u5"))
+
+	access, err := repo.CheckAccess(context.Background(), "u2", "access", domain.Resource{Type: "license", ID: "o1/media"})
+	assert.NoError(t, err)
+	assert.False(t, bool(access), "Expired licenses should NOT grant access.")
+
+	manage, err := repo.CheckAccess(context.Background(), "u5", "manage_license", domain.Resource{Type: "license", ID: "o1/media"})
+	assert.NoError(t, err)
+	assert.True(t, bool(manage), "Delegated admins should still manage expired licenses.")
+}
+
+func TestGetLicense(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	lic, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "o1", lic.OrgID)
+	assert.Equal(t, "smarts", lic.ServiceID)
+	assert.Equal(t, 10, lic.MaxSeats)
+	assert.Equal(t, 2, lic.InUse) //u1, u3
+}
+
+func TestGetAssignable(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	assignable, err := repo.GetAssignable(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	initialAssignableUsers := []domain.SubjectID{"u2", "u5", "u6", "u7", "u8", "u9", "u10", "u11", "u12", "u13", "u14", "u15", "u16", "u17", "u18", "u19", "u20"}
+
+	assert.ElementsMatch(t, initialAssignableUsers, assignable)
+}
+
+func TestGetAssigned(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	assigned, err := repo.GetAssigned(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, []domain.SubjectID{"u1", "u3"}, assigned)
+}
+
+func TestAssignUnassign(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	oldLic, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+
+	err = repo.ModifySeats(context.Background(), []domain.SubjectID{"u2"}, []domain.SubjectID{}, oldLic, "o1", domain.Service{ID: "smarts"})
+	assert.NoError(t, err)
+
+	lic, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, lic.InUse) //u1, u2, u3
+
+	err = repo.ModifySeats(context.Background(), []domain.SubjectID{}, []domain.SubjectID{"u2"}, lic, "o1", domain.Service{ID: "smarts"})
+	assert.NoError(t, err)
+
+	lic, err = repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, lic.InUse) //u1, u3
+}
+
+func TestFailAssignBatchIfOneDisabled(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	oldLic, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+
+	err = repo.ModifySeats(context.Background(), []domain.SubjectID{"u5", "u4"}, []domain.SubjectID{}, oldLic, "o1", domain.Service{ID: "smarts"})
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	assigned, err := repo.GetAssigned(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []domain.SubjectID{"u1", "u3"}, assigned) // if error in batch nothing gets applied.
+}
+
+func TestModifySeatsFailsOnStaleLicenseVersion(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	staleLic, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+
+	err = repo.ModifySeats(context.Background(), []domain.SubjectID{"u5"}, []domain.SubjectID{}, staleLic, "o1", domain.Service{ID: "smarts"})
+	assert.NoError(t, err)
+
+	err = repo.ModifySeats(context.Background(), []domain.SubjectID{"u6"}, []domain.SubjectID{}, staleLic, "o1", domain.Service{ID: "smarts"})
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	lic, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, lic.InUse) //u1, u3, u5
+}
+
+func TestUnassignNotAssigned(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	licBefore, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+
+	err = repo.ModifySeats(context.Background(), []domain.SubjectID{}, []domain.SubjectID{"not_assigned"}, licBefore, "o1", domain.Service{ID: "smarts"})
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	licAfter, err := repo.GetLicense(context.Background(), "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, licBefore.InUse, licAfter.InUse)
+}
+
+func TestApplyLicenseTwiceConflicts(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	err := repo.ApplyLicense(context.Background(), domain.NewLicense("o2", "smarts", 5, 0))
+	assert.NoError(t, err)
+
+	err = repo.ApplyLicense(context.Background(), domain.NewLicense("o2", "smarts", 7, 0))
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	lic, err := repo.GetLicense(context.Background(), "o2", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, lic.MaxSeats)
+
+	licensed, err := repo.HasAnyLicense(context.Background(), "o2")
+	assert.NoError(t, err)
+	assert.True(t, licensed)
+}
+
+func TestHasAnyLicense(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	for orgID, expected := range map[string]bool{"o1": true, "oNoUsers": true, "o2": false, "unknownOrgId": false} {
+		result, err := repo.HasAnyLicense(context.Background(), orgID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result, "Unexpected result for org %s", orgID)
+	}
+}
+
+func TestAddSubjectTwiceFails(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	err := repo.AddSubject(context.Background(), "o1", domain.Subject{SubjectID: "u1", Enabled: false})
+	assert.ErrorIs(t, err, domain.ErrSubjectAlreadyExists)
+
+	enabled, err := repo.CheckAccess(context.Background(), "u1", "enabled_users", domain.Resource{Type: "org", ID: "o1"})
+	assert.NoError(t, err)
+	assert.True(t, bool(enabled))
+}
+
+func TestUpsertUser(t *testing.T) {
+	t.Parallel()
+	repo := seededRepository(t)
+
+	tests := []domain.Subject{
+		{SubjectID: "u1", Enabled: true},  //Enabled in seed data
+		{SubjectID: "u3", Enabled: true},  //Disabled in seed data
+		{SubjectID: "u4", Enabled: false}, //Disabled in seed data
+		{SubjectID: "u2", Enabled: false}, //Enabled in seed data
+		{SubjectID: "new-enabled", Enabled: true},
+		{SubjectID: "new-disabled", Enabled: false},
+	}
+
+	for _, subject := range tests {
+		err := repo.UpsertSubject(context.Background(), "o1", subject)
+		assert.NoError(t, err)
+
+		member, err := repo.CheckAccess(context.Background(), subject.SubjectID, "member", domain.Resource{Type: "org", ID: "o1"})
+		assert.NoError(t, err)
+		assert.True(t, bool(member))
+
+		tombstoned, err := repo.CheckAccess(context.Background(), subject.SubjectID, "disabled", domain.Resource{Type: "org", ID: "o1"})
+		assert.NoError(t, err)
+		assert.Equal(t, !subject.Enabled, bool(tombstoned))
+	}
+}
+
+func seededRepository(t *testing.T) *InMemoryAccessRepository {
+	repo, err := NewSeededInMemoryAccessRepository()
+	if err != nil {
+		t.Fatalf("Error seeding in-memory repository: %s", err)
+	}
+
+	return repo
+}
+
+func TestInMemoryAccessRepositoryConformance(t *testing.T) {
+	contracttest.RunSeatLicenseRepositoryTests(t, func(t *testing.T) contracttest.Harness {
+		repo := NewInMemoryAccessRepository()
+		outbox := NewInMemoryOutbox()
+		repo.EnableOutbox(outbox)
+		return contracttest.Harness{Access: repo, Seats: repo, Orgs: repo, Outbox: outbox}
+	})
+}
+
+func TestInMemoryWebhookRepositoryConformance(t *testing.T) {
+	contracttest.RunWebhookRepositoryTests(t, func(t *testing.T) contracts.WebhookRepository {
+		return NewInMemoryWebhookRepository()
+	})
+}
+
+func TestInMemoryDeadLetterRepositoryConformance(t *testing.T) {
+	contracttest.RunDeadLetterRepositoryTests(t, func(t *testing.T) contracts.DeadLetterRepository {
+		return NewInMemoryDeadLetterRepository()
+	})
+}
@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"authz/domain"
+	"context"
+	"sort"
+	"sync"
+)
+
+// InMemoryDeadLetterRepository is a process-local DeadLetterRepository, dead letters are lost on restart
+type InMemoryDeadLetterRepository struct {
+	mu          sync.RWMutex
+	deadLetters map[string]domain.DeadLetter
+}
+
+// NewInMemoryDeadLetterRepository constructs a new, empty InMemoryDeadLetterRepository
+func NewInMemoryDeadLetterRepository() *InMemoryDeadLetterRepository {
+	return &InMemoryDeadLetterRepository{deadLetters: make(map[string]domain.DeadLetter)}
+}
+
+// AddDeadLetter stores a new dead letter
+func (r *InMemoryDeadLetterRepository) AddDeadLetter(_ context.Context, letter domain.DeadLetter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.deadLetters[letter.ID]; ok {
+		return domain.ErrConflict
+	}
+
+	r.deadLetters[letter.ID] = letter
+	return nil
+}
+
+// GetDeadLetters retrieves all dead letters, ordered by ID
+func (r *InMemoryDeadLetterRepository) GetDeadLetters(_ context.Context) ([]domain.DeadLetter, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	letters := make([]domain.DeadLetter, 0, len(r.deadLetters))
+	for _, letter := range r.deadLetters {
+		letters = append(letters, letter)
+	}
+
+	sort.Slice(letters, func(i, j int) bool {
+		return letters[i].ID < letters[j].ID
+	})
+
+	return letters, nil
+}
+
+// GetDeadLetter retrieves a single dead letter
+func (r *InMemoryDeadLetterRepository) GetDeadLetter(_ context.Context, id string) (domain.DeadLetter, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	letter, ok := r.deadLetters[id]
+	if !ok {
+		return domain.DeadLetter{}, domain.ErrDeadLetterNotFound
+	}
+
+	return letter, nil
+}
+
+// RemoveDeadLetter deletes a dead letter
+func (r *InMemoryDeadLetterRepository) RemoveDeadLetter(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.deadLetters[id]; !ok {
+		return domain.ErrDeadLetterNotFound
+	}
+
+	delete(r.deadLetters, id)
+	return nil
+}
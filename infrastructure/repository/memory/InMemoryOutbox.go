@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"authz/domain"
+	"context"
+	"fmt"
+	"sync"
+)
+
+// InMemoryOutbox is a process-local OutboxRepository. An InMemoryAccessRepository records its license events in it, see EnableOutbox.
+type InMemoryOutbox struct {
+	mu     sync.RWMutex
+	events []domain.OutboxEvent
+	seq    uint64
+}
+
+// NewInMemoryOutbox constructs a new, empty outbox
+func NewInMemoryOutbox() *InMemoryOutbox {
+	return &InMemoryOutbox{}
+}
+
+// GetPendingEvents retrieves up to limit events that were not marked as sent yet, oldest first
+func (o *InMemoryOutbox) GetPendingEvents(_ context.Context, limit int) ([]domain.OutboxEvent, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	if len(o.events) < limit {
+		limit = len(o.events)
+	}
+
+	return append([]domain.OutboxEvent(nil), o.events[:limit]...), nil
+}
+
+// MarkEventsSent removes the events with the given IDs from the outbox
+func (o *InMemoryOutbox) MarkEventsSent(_ context.Context, ids []string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	sent := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		sent[id] = true
+	}
+
+	pending := o.events[:0]
+	for _, evt := range o.events {
+		if !sent[evt.ID] {
+			pending = append(pending, evt)
+		}
+	}
+	o.events = pending
+
+	return nil
+}
+
+// record appends the given events to the outbox
+func (o *InMemoryOutbox) record(evts []domain.LicenseEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, evt := range evts {
+		o.seq++
+		o.events = append(o.events, domain.OutboxEvent{ID: fmt.Sprintf("%020d", o.seq), LicenseEvent: evt})
+	}
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"authz/infrastructure/snapshot"
+	"context"
+	"fmt"
+)
+
+// ReadRelationships returns all relationships of the resource types, read under one lock. The store keeps no revisions, so the returned revision is empty.
+func (m *InMemoryAccessRepository) ReadRelationships(_ context.Context, resourceTypes []string) ([]snapshot.Relationship, string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result []snapshot.Relationship
+	for _, resourceType := range resourceTypes {
+		for _, rel := range m.filter(resourceType, "", "", "", "") {
+			result = append(result, snapshot.Relationship{
+				ResourceType: rel.ResourceType,
+				ResourceID:   rel.ResourceID,
+				Relation:     rel.Relation,
+				SubjectType:  rel.SubjectType,
+				SubjectID:    rel.SubjectID,
+			})
+		}
+	}
+
+	return result, "", nil
+}
+
+// UpdateRelationships creates the relationships to touch unless they exist and deletes the relationships to remove, all at once
+func (m *InMemoryAccessRepository) UpdateRelationships(_ context.Context, touch []snapshot.Relationship, remove []snapshot.Relationship) error {
+	for _, rels := range [][]snapshot.Relationship{touch, remove} {
+		for _, rel := range rels {
+			if rel.SubjectRelation != "" {
+				return fmt.Errorf("invalid relationship %s: subject relations are not supported", rel)
+			}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, rel := range remove {
+		m.remove(rel.ResourceType, rel.ResourceID, rel.Relation, rel.SubjectType, rel.SubjectID)
+	}
+	for _, rel := range touch {
+		m.add(rel.ResourceType, rel.ResourceID, rel.Relation, rel.SubjectType, rel.SubjectID)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"authz/domain"
+	"authz/infrastructure/snapshot"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotRestoresLicensesSeatsAndMemberships(t *testing.T) {
+	t.Parallel()
+	source := seededRepository(t)
+	ctx := context.Background()
+
+	exported, err := snapshot.Export(ctx, source)
+	assert.NoError(t, err)
+
+	target := NewInMemoryAccessRepository()
+	result, err := snapshot.Import(ctx, target, exported)
+	assert.NoError(t, err)
+	assert.NotZero(t, result.Created)
+
+	license, err := target.GetLicense(ctx, "o1", "smarts")
+	assert.NoError(t, err)
+	expected, err := source.GetLicense(ctx, "o1", "smarts")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, license)
+
+	assigned, err := target.GetAssigned(ctx, "o1", "smarts")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []domain.SubjectID{"u1", "u3"}, assigned)
+	disabled, err := target.CheckAccess(ctx, "u4", "disabled", domain.Resource{Type: "org", ID: "o1"})
+	assert.NoError(t, err)
+	assert.True(t, bool(disabled))
+
+	result, err = snapshot.Import(ctx, target, exported)
+	assert.NoError(t, err)
+	assert.Equal(t, snapshot.ImportResult{}, result)
+
+	reexported, err := snapshot.Export(ctx, target)
+	assert.NoError(t, err)
+	assert.Equal(t, exported.Relationships, reexported.Relationships)
+}
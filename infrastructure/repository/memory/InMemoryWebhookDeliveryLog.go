@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"authz/domain"
+	"context"
+	"sync"
+)
+
+// InMemoryWebhookDeliveryLog is a process-local WebhookDeliveryLog that keeps the most recent deliveries per webhook
+type InMemoryWebhookDeliveryLog struct {
+	mu         sync.RWMutex
+	maxEntries int
+	deliveries map[string][]domain.WebhookDelivery
+}
+
+// NewInMemoryWebhookDeliveryLog constructs a new, empty log keeping up to maxEntries deliveries per webhook
+func NewInMemoryWebhookDeliveryLog(maxEntries int) *InMemoryWebhookDeliveryLog {
+	return &InMemoryWebhookDeliveryLog{
+		maxEntries: maxEntries,
+		deliveries: make(map[string][]domain.WebhookDelivery),
+	}
+}
+
+// AddDelivery records an attempt to deliver an event, discarding the oldest delivery to the webhook if the log is full
+func (l *InMemoryWebhookDeliveryLog) AddDelivery(_ context.Context, delivery domain.WebhookDelivery) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	deliveries := append(l.deliveries[delivery.WebhookID], delivery)
+	if len(deliveries) > l.maxEntries {
+		deliveries = deliveries[len(deliveries)-l.maxEntries:]
+	}
+	l.deliveries[delivery.WebhookID] = deliveries
+
+	return nil
+}
+
+// GetDeliveries retrieves the recorded attempts to deliver events to a webhook, most recent first
+func (l *InMemoryWebhookDeliveryLog) GetDeliveries(_ context.Context, webhookID string) ([]domain.WebhookDelivery, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	deliveries := l.deliveries[webhookID]
+	result := make([]domain.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		result[len(deliveries)-1-i] = delivery
+	}
+
+	return result, nil
+}
@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"authz/domain"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliveriesAreListedPerWebhookMostRecentFirst(t *testing.T) {
+	log := NewInMemoryWebhookDeliveryLog(10)
+	assert.NoError(t, log.AddDelivery(context.Background(), domain.WebhookDelivery{WebhookID: "w1", Attempt: 1, Error: "timeout"}))
+	assert.NoError(t, log.AddDelivery(context.Background(), domain.WebhookDelivery{WebhookID: "w2", Attempt: 1}))
+	assert.NoError(t, log.AddDelivery(context.Background(), domain.WebhookDelivery{WebhookID: "w1", Attempt: 2}))
+
+	deliveries, err := log.GetDeliveries(context.Background(), "w1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.WebhookDelivery{{WebhookID: "w1", Attempt: 2}, {WebhookID: "w1", Attempt: 1, Error: "timeout"}}, deliveries)
+}
+
+func TestDeliveryLogDiscardsOldestDeliveries(t *testing.T) {
+	log := NewInMemoryWebhookDeliveryLog(2)
+	for i := 1; i <= 3; i++ {
+		assert.NoError(t, log.AddDelivery(context.Background(), domain.WebhookDelivery{WebhookID: "w1", Attempt: i}))
+	}
+
+	deliveries, err := log.GetDeliveries(context.Background(), "w1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.WebhookDelivery{{WebhookID: "w1", Attempt: 3}, {WebhookID: "w1", Attempt: 2}}, deliveries)
+}
+
+func TestDeliveriesOfUnknownWebhookAreEmpty(t *testing.T) {
+	log := NewInMemoryWebhookDeliveryLog(2)
+
+	deliveries, err := log.GetDeliveries(context.Background(), "unknown")
+
+	assert.NoError(t, err)
+	assert.Empty(t, deliveries)
+}
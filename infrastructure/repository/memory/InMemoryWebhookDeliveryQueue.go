@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"authz/domain"
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// InMemoryWebhookDeliveryQueue is a process-local WebhookDeliveryQueue, pending deliveries are lost on restart
+type InMemoryWebhookDeliveryQueue struct {
+	mu      sync.Mutex
+	pending map[string]domain.PendingWebhookDelivery
+}
+
+// NewInMemoryWebhookDeliveryQueue constructs a new, empty queue
+func NewInMemoryWebhookDeliveryQueue() *InMemoryWebhookDeliveryQueue {
+	return &InMemoryWebhookDeliveryQueue{pending: make(map[string]domain.PendingWebhookDelivery)}
+}
+
+// AddPendingDeliveries stores new pending deliveries
+func (q *InMemoryWebhookDeliveryQueue) AddPendingDeliveries(_ context.Context, deliveries []domain.PendingWebhookDelivery) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, delivery := range deliveries {
+		q.pending[delivery.ID] = delivery
+	}
+
+	return nil
+}
+
+// GetDueDeliveries retrieves up to limit pending deliveries that are due at the given time, ordered by ID
+func (q *InMemoryWebhookDeliveryQueue) GetDueDeliveries(_ context.Context, now time.Time, limit int) ([]domain.PendingWebhookDelivery, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	due := make([]domain.PendingWebhookDelivery, 0)
+	for _, delivery := range q.pending {
+		if delivery.Due(now) {
+			due = append(due, delivery)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].ID < due[j].ID
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	return due, nil
+}
+
+// UpdatePendingDelivery replaces a pending delivery after a failed attempt
+func (q *InMemoryWebhookDeliveryQueue) UpdatePendingDelivery(_ context.Context, delivery domain.PendingWebhookDelivery) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.pending[delivery.ID] = delivery
+	return nil
+}
+
+// RemovePendingDelivery deletes a pending delivery that succeeded or was given up
+func (q *InMemoryWebhookDeliveryQueue) RemovePendingDelivery(_ context.Context, id string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.pending, id)
+	return nil
+}
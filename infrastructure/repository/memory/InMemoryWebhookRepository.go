@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"authz/domain"
+	"context"
+	"sort"
+	"sync"
+)
+
+// InMemoryWebhookRepository is a process-local WebhookRepository, webhooks are lost on restart
+type InMemoryWebhookRepository struct {
+	mu       sync.RWMutex
+	webhooks map[string]domain.Webhook
+}
+
+// NewInMemoryWebhookRepository constructs a new, empty InMemoryWebhookRepository
+func NewInMemoryWebhookRepository() *InMemoryWebhookRepository {
+	return &InMemoryWebhookRepository{webhooks: make(map[string]domain.Webhook)}
+}
+
+// AddWebhook stores a new webhook
+func (r *InMemoryWebhookRepository) AddWebhook(_ context.Context, webhook domain.Webhook) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.webhooks[webhook.ID]; ok {
+		return domain.ErrConflict
+	}
+
+	r.webhooks[webhook.ID] = webhook
+	return nil
+}
+
+// GetWebhooks retrieves the webhooks of an organization, ordered by ID
+func (r *InMemoryWebhookRepository) GetWebhooks(_ context.Context, orgID string) ([]domain.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	webhooks := make([]domain.Webhook, 0)
+	for _, webhook := range r.webhooks {
+		if webhook.OrgID == orgID {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool {
+		return webhooks[i].ID < webhooks[j].ID
+	})
+
+	return webhooks, nil
+}
+
+// RemoveWebhook deletes a webhook of an organization
+func (r *InMemoryWebhookRepository) RemoveWebhook(_ context.Context, orgID string, webhookID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if webhook, ok := r.webhooks[webhookID]; !ok || webhook.OrgID != orgID {
+		return domain.ErrWebhookNotFound
+	}
+
+	delete(r.webhooks, webhookID)
+	return nil
+}
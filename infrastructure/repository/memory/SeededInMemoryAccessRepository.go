@@ -0,0 +1,37 @@
+//go:build !release
+
+package memory
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+
+	"gopkg.in/yaml.v3"
+)
+
+// NewSeededInMemoryAccessRepository creates a new InMemoryAccessRepository loaded with the same seed data used for the SpiceDB test container. Only used for test setup and not included in builds with the release tag
+func NewSeededInMemoryAccessRepository() (*InMemoryAccessRepository, error) {
+	var (
+		_, b, _, _ = runtime.Caller(0)
+		basepath   = filepath.Dir(b)
+	)
+
+	content, err := os.ReadFile(path.Join(basepath, "../../../schema/spicedb_bootstrap_relations.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	var bootstrap struct {
+		Relationships string `yaml:"relationships"`
+	}
+	if err = yaml.Unmarshal(content, &bootstrap); err != nil {
+		return nil, err
+	}
+
+	repo := NewInMemoryAccessRepository()
+	err = repo.LoadRelationships(bootstrap.Relationships)
+
+	return repo, err
+}
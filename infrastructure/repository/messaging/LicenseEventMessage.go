@@ -0,0 +1,39 @@
+package messaging
+
+import (
+	"authz/domain"
+	"encoding/json"
+	"time"
+)
+
+// LicenseEventMessage represents a message to the UMB about a change to a license or its seat assignments
+type LicenseEventMessage struct {
+	EventType      string `json:"eventType"`
+	OrgID          string `json:"orgId"`
+	ServiceID      string `json:"serviceId"`
+	SubjectID      string `json:"subjectId,omitempty"`
+	SeatsTotal     int    `json:"seatsTotal"`
+	SeatsAvailable int    `json:"seatsAvailable"`
+	// PreviousSeatsTotal is the seat limit before a LicenseUpdated event
+	PreviousSeatsTotal int       `json:"previousSeatsTotal,omitempty"`
+	Timestamp          time.Time `json:"timestamp"`
+}
+
+// NewLicenseEventMessage creates the message for the given event, sent at the given time
+func NewLicenseEventMessage(evt domain.LicenseEvent, timestamp time.Time) LicenseEventMessage {
+	return LicenseEventMessage{
+		EventType:          string(evt.Type),
+		OrgID:              evt.OrgID,
+		ServiceID:          evt.ServiceID,
+		SubjectID:          string(evt.SubjectID),
+		SeatsTotal:         evt.SeatsTotal,
+		SeatsAvailable:     evt.SeatsAvailable,
+		PreviousSeatsTotal: evt.PreviousSeatsTotal,
+		Timestamp:          timestamp.UTC(),
+	}
+}
+
+// Marshal serializes the message to JSON
+func (m LicenseEventMessage) Marshal() ([]byte, error) {
+	return json.Marshal(m)
+}
@@ -0,0 +1,36 @@
+package messaging
+
+import (
+	"authz/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLicenseEventMessageSerializesSeatEvents(t *testing.T) {
+	evt := domain.LicenseEvent{Type: domain.SeatAssigned, OrgID: "o1", ServiceID: "smarts", SubjectID: "u1", SeatsTotal: 10, SeatsAvailable: 7}
+
+	data, err := NewLicenseEventMessage(evt, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).Marshal()
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"eventType": "SeatAssigned", "orgId": "o1", "serviceId": "smarts", "subjectId": "u1", "seatsTotal": 10, "seatsAvailable": 7, "timestamp": "2024-01-01T00:00:00Z"}`, string(data))
+}
+
+func TestLicenseEventMessageOmitsSubjectOfLicenseEvents(t *testing.T) {
+	evt := domain.LicenseEvent{Type: domain.LicenseEntitled, OrgID: "o1", ServiceID: "smarts", SeatsTotal: 5, SeatsAvailable: 5}
+
+	data, err := NewLicenseEventMessage(evt, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).Marshal()
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"eventType": "LicenseEntitled", "orgId": "o1", "serviceId": "smarts", "seatsTotal": 5, "seatsAvailable": 5, "timestamp": "2024-01-01T00:00:00Z"}`, string(data))
+}
+
+func TestLicenseEventMessageCarriesPreviousLimitOfUpdates(t *testing.T) {
+	evt := domain.LicenseEvent{Type: domain.LicenseUpdated, OrgID: "o1", ServiceID: "smarts", SeatsTotal: 8, SeatsAvailable: 6, PreviousSeatsTotal: 5}
+
+	data, err := NewLicenseEventMessage(evt, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).Marshal()
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"eventType": "LicenseUpdated", "orgId": "o1", "serviceId": "smarts", "seatsTotal": 8, "seatsAvailable": 6, "previousSeatsTotal": 5, "timestamp": "2024-01-01T00:00:00Z"}`, string(data))
+}
@@ -40,6 +40,11 @@ func (e SubjectEventMessage) IsUpdated() bool {
 	return e.Header.Operation == "updated"
 }
 
+// IsDeleted returns true if the event represents a deleted subject
+func (e SubjectEventMessage) IsDeleted() bool {
+	return e.Header.Operation == "delete"
+}
+
 // IsActive returns true if the subject is currently active, else false
 func (e SubjectEventMessage) IsActive() bool {
 	return e.Payload.Sync.User.Status.State == "Active"
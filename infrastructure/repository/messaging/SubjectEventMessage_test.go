@@ -0,0 +1,36 @@
+package messaging
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeletionMessageIsParsed(t *testing.T) {
+	var evt SubjectEventMessage
+	err := xml.Unmarshal([]byte(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+	<CanonicalMessage xmlns="http://esb.redhat.com/Canonical/6">
+	   <Header>
+		   <System>WEB</System>
+		   <Operation>delete</Operation>
+		   <Type>User</Type>
+	   </Header>
+	   <Payload>
+		   <Sync>
+			   <User>
+				   <Identifiers>
+					   <Identifier system="WEB" entity-name="User" qualifier="id">u1</Identifier>
+					   <Reference system="WEB" entity-name="Customer" qualifier="id">o1</Reference>
+				   </Identifiers>
+			   </User>
+		   </Sync>
+	   </Payload>
+	</CanonicalMessage>`), &evt)
+
+	assert.NoError(t, err)
+	assert.True(t, evt.IsDeleted())
+	assert.False(t, evt.IsActive())
+	assert.Equal(t, "u1", evt.SubjectID())
+	assert.Equal(t, "o1", evt.OrgID())
+}
@@ -3,6 +3,7 @@ package messaging
 
 import (
 	"authz/bootstrap/serviceconfig"
+	"authz/domain"
 	"authz/domain/contracts"
 	"context"
 	"crypto/tls"
@@ -10,6 +11,7 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -26,11 +28,15 @@ const (
 type UMBMessageBusRepository struct {
 	config      serviceconfig.UMBConfig
 	conn        *amqp.Conn
+	connected   atomic.Bool
 	subjectRecv *amqp.Receiver
+	licenseSend *amqp.Sender
+	sendMu      sync.Mutex
 	recvCtx     context.Context
 	recvCancel  context.CancelFunc
 	errs        chan error
 	changes     chan contracts.SubjectAddOrUpdateEvent
+	unparseable chan contracts.UnparseableMessage
 	workerDone  chan interface{}
 	numWorkers  int32
 }
@@ -83,8 +89,17 @@ func (r *UMBMessageBusRepository) Connect() (evts contracts.UserEvents, err erro
 		return
 	}
 
+	if r.config.LicenseEventsTopic != "" {
+		err = r.createLicenseEventSender(session)
+		if err != nil {
+			return
+		}
+	}
+	r.connected.Store(true)
+
 	return contracts.UserEvents{
 		SubjectChanges: r.changes,
+		Unparseable:    r.unparseable,
 		Errors:         r.errs,
 	}, nil
 }
@@ -131,9 +146,11 @@ func (r *UMBMessageBusRepository) receiveSubjectChanges(s *amqp.Session) (chan c
 				r.errs <- err
 
 				if isConnectivityError(err) {
+					r.connected.Store(false)
 					go r.reconnect()
 					return
 				}
+				continue
 			}
 
 			var evt SubjectEventMessage
@@ -143,33 +160,25 @@ func (r *UMBMessageBusRepository) receiveSubjectChanges(s *amqp.Session) (chan c
 			}
 
 			err = xml.Unmarshal([]byte(body), &evt)
-			if err != nil {
-				r.errs <- err
+			if err == nil && evt.OrgID() == "" && !evt.IsDeleted() {
+				err = fmt.Errorf("Unable to extract orgID from subject event. SubjectID: %s, IsUpdate: %t", evt.SubjectID(), evt.IsActive())
+			}
 
-				err = r.subjectRecv.RejectMessage(ctx, msg, nil)
-				if err != nil {
-					r.errs <- err
-				}
+			if err != nil {
+				r.rejectUnparseable(ctx, msg, body, err)
 				continue
 			}
 
 			glog.Infof("Message received. Unmarshalled Payload: %+v", evt)
 
-			if evt.OrgID() == "" {
-				r.errs <- fmt.Errorf("Unable to extract orgID from subject event. SubjectID: %s, IsUpdate: %t", evt.SubjectID(), evt.IsActive())
-
-				err = r.subjectRecv.RejectMessage(ctx, msg, nil)
-				if err != nil {
-					r.errs <- err
-				}
-				continue
-			}
-
 			r.changes <- contracts.SubjectAddOrUpdateEvent{
-				MsgRef:    msg,
-				SubjectID: evt.SubjectID(),
-				OrgID:     evt.OrgID(),
-				Active:    evt.IsActive(),
+				MsgRef:           msg,
+				SubjectID:        evt.SubjectID(),
+				OrgID:            evt.OrgID(),
+				Active:           evt.IsActive() && !evt.IsDeleted(),
+				Deleted:          evt.IsDeleted(),
+				DeliveryAttempts: deliveryAttempts(msg),
+				Payload:          body,
 			}
 		}
 	}()
@@ -177,6 +186,71 @@ func (r *UMBMessageBusRepository) receiveSubjectChanges(s *amqp.Session) (chan c
 	return r.changes, nil
 }
 
+// rejectUnparseable rejects a message that cannot be turned into an event, so that it is not delivered again, and passes it on for inspection
+func (r *UMBMessageBusRepository) rejectUnparseable(ctx context.Context, msg *amqp.Message, body string, parseErr error) {
+	err := r.subjectRecv.RejectMessage(ctx, msg, &amqp.Error{Condition: amqp.ErrCondDecodeError, Description: parseErr.Error()})
+	if err != nil {
+		r.errs <- err
+	}
+
+	r.unparseable <- contracts.UnparseableMessage{Payload: body, Err: parseErr}
+}
+
+// deliveryAttempts returns the number of times the broker delivered the message, including this delivery
+func deliveryAttempts(msg *amqp.Message) int {
+	if msg.Header == nil {
+		return 1
+	}
+
+	return int(msg.Header.DeliveryCount) + 1
+}
+
+func (r *UMBMessageBusRepository) createLicenseEventSender(s *amqp.Session) error {
+	sender, err := s.NewSender(context.Background(), r.config.LicenseEventsTopic, nil)
+	if err != nil {
+		return err
+	}
+
+	r.sendMu.Lock()
+	r.licenseSend = sender
+	r.sendMu.Unlock()
+
+	return nil
+}
+
+// PublishLicenseEvents sends the given events to the license events topic in order. It fails if the topic is not configured or the repository is not connected.
+func (r *UMBMessageBusRepository) PublishLicenseEvents(ctx context.Context, evts []domain.LicenseEvent) error {
+	r.sendMu.Lock()
+	defer r.sendMu.Unlock()
+
+	if r.licenseSend == nil {
+		return fmt.Errorf("not connected to license events topic %q", r.config.LicenseEventsTopic)
+	}
+
+	contentType := "application/json"
+	for _, evt := range evts {
+		data, err := NewLicenseEventMessage(evt, time.Now()).Marshal()
+		if err != nil {
+			return err
+		}
+
+		msg := amqp.NewMessage(data)
+		msg.Properties = &amqp.MessageProperties{ContentType: &contentType}
+		msg.ApplicationProperties = map[string]any{"eventType": string(evt.Type)}
+
+		sendCtx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(r.config.ConnectTimeoutSeconds))
+		err = r.licenseSend.Send(sendCtx, msg, nil)
+		cancel()
+		if err != nil {
+			// Connectivity errors are recovered from by the receiving worker, which reconnects
+			glog.Errorf("Failed to publish %s event for license %s of org %s: %v", evt.Type, evt.ServiceID, evt.OrgID, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ReportSuccess sends confirmation to the broker that the message was processed successfully. This or ReportFailure MUST be called for any event received.
 func (r *UMBMessageBusRepository) ReportSuccess(evt contracts.SubjectAddOrUpdateEvent) error {
 	msg, ok := evt.MsgRef.(*amqp.Message)
@@ -188,11 +262,22 @@ func (r *UMBMessageBusRepository) ReportSuccess(evt contracts.SubjectAddOrUpdate
 	return fmt.Errorf("Internal error. MsgRef is not of expected type: %+v", evt.MsgRef)
 }
 
-// ReportFailure informs the broker that the message was -not- processed successfully. This or ReportSuccess MUST be called for any event received.
+// ReportFailure informs the broker that the message was -not- processed successfully. The broker counts the failed delivery and delivers the message again.
 func (r *UMBMessageBusRepository) ReportFailure(evt contracts.SubjectAddOrUpdateEvent) error {
 	msg, ok := evt.MsgRef.(*amqp.Message)
 	if ok {
-		err := r.subjectRecv.ReleaseMessage(context.TODO(), msg)
+		err := r.subjectRecv.ModifyMessage(context.TODO(), msg, &amqp.ModifyMessageOptions{DeliveryFailed: true})
+		return err
+	}
+
+	return fmt.Errorf("Internal error. MsgRef is not of expected type: %+v", evt.MsgRef)
+}
+
+// ReportDeadLettered rejects the message, so that the broker does not deliver it again
+func (r *UMBMessageBusRepository) ReportDeadLettered(evt contracts.SubjectAddOrUpdateEvent, reason string) error {
+	msg, ok := evt.MsgRef.(*amqp.Message)
+	if ok {
+		err := r.subjectRecv.RejectMessage(context.TODO(), msg, &amqp.Error{Condition: amqp.ErrCondInternalError, Description: reason})
 		return err
 	}
 
@@ -215,9 +300,20 @@ func (r *UMBMessageBusRepository) Disconnect() {
 
 	close(r.errs)
 	close(r.changes)
+	close(r.unparseable)
+}
+
+// IsConnected returns true while the connection to the broker is established
+func (r *UMBMessageBusRepository) IsConnected() bool {
+	return r.connected.Load()
 }
 
 func (r *UMBMessageBusRepository) repeatableDisconnect() {
+	r.connected.Store(false)
+	r.sendMu.Lock()
+	r.licenseSend = nil // closed together with the connection
+	r.sendMu.Unlock()
+
 	r.recvCancel()
 	for r.numWorkers > 0 {
 		<-r.workerDone
@@ -233,9 +329,10 @@ func (r *UMBMessageBusRepository) repeatableDisconnect() {
 // NewUMBMessageBusRepository constructs a new UMBMessageBusRepository with the given configuration
 func NewUMBMessageBusRepository(config serviceconfig.UMBConfig) *UMBMessageBusRepository {
 	return &UMBMessageBusRepository{
-		config:     config,
-		workerDone: make(chan interface{}),
-		errs:       make(chan error),
-		changes:    make(chan contracts.SubjectAddOrUpdateEvent),
+		config:      config,
+		workerDone:  make(chan interface{}),
+		errs:        make(chan error),
+		changes:     make(chan contracts.SubjectAddOrUpdateEvent),
+		unparseable: make(chan contracts.UnparseableMessage),
 	}
 }
@@ -2,6 +2,7 @@ package messaging
 
 import (
 	"authz/bootstrap/serviceconfig"
+	"authz/domain"
 	"authz/domain/contracts"
 	"authz/testenv"
 	"context"
@@ -85,6 +86,30 @@ func TestUMBMessageRepository_receives_user_reactivation_events(t *testing.T) {
 	assertNoErrors(t, evts.Errors)
 }
 
+func TestUMBMessageRepository_receives_user_deletion_events(t *testing.T) {
+	//given
+	t.SkipNow() //Skipped pending a local test mechanism
+	sent := contracts.SubjectAddOrUpdateEvent{
+		SubjectID: "u1",
+		OrgID:     "o1",
+		Deleted:   true,
+	}
+
+	repo := createUMBRepository()
+	defer repo.Disconnect()
+
+	evts, err := repo.Connect()
+	assert.NoError(t, err)
+	//When
+	err = localBrokerContainer.SendSubjectDeleted(sent)
+	//Then
+	assert.NoError(t, err)
+	received := <-evts.SubjectChanges
+
+	assert.Equal(t, sent, received)
+	assertNoErrors(t, evts.Errors)
+}
+
 func TestUMBMessageRepository_disconnects_successfully(t *testing.T) {
 	//Given
 	t.SkipNow() //Skipped pending a local test mechanism
@@ -106,6 +131,31 @@ func TestUMBMessageRepository_disconnects_successfully(t *testing.T) {
 	assert.False(t, open)
 }
 
+func TestUMBMessageRepository_publishes_license_events(t *testing.T) {
+	//Given
+	t.SkipNow() //Skipped pending a local test mechanism
+	repo := createUMBRepository()
+	repo.config.LicenseEventsTopic = "VirtualTopic.services.authz.license"
+	defer repo.Disconnect()
+
+	_, err := repo.Connect()
+	assert.NoError(t, err)
+	recv, err := localBrokerContainer.CreateReciever(repo.config.LicenseEventsTopic)
+	assert.NoError(t, err)
+
+	//When
+	err = repo.PublishLicenseEvents(context.Background(), []domain.LicenseEvent{
+		{Type: domain.SeatAssigned, OrgID: "o1", ServiceID: "smarts", SubjectID: "u2", SeatsTotal: 10, SeatsAvailable: 7},
+	})
+
+	//Then
+	assert.NoError(t, err)
+	msg, err := recv.Receive(context.TODO(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "SeatAssigned", msg.ApplicationProperties["eventType"])
+	assert.Contains(t, string(msg.GetData()), `"subjectId":"u2"`)
+}
+
 func createUMBRepository() *UMBMessageBusRepository {
 	return NewUMBMessageBusRepository(serviceconfig.UMBConfig{
 		URL:               "",
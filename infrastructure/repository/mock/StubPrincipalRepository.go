@@ -3,6 +3,7 @@ package mock
 
 import (
 	"authz/domain"
+	"context"
 	"fmt"
 )
 
@@ -13,7 +14,7 @@ type StubPrincipalRepository struct {
 }
 
 // GetByID retrieves a principal for the given ID. If no ID is provided (ex: empty string), it returns an anonymous principal. If any error occurs, it's returned.
-func (s *StubPrincipalRepository) GetByID(id domain.SubjectID) (domain.Principal, error) {
+func (s *StubPrincipalRepository) GetByID(ctx context.Context, id domain.SubjectID) (domain.Principal, error) {
 	if id == "" {
 		return domain.NewAnonymousPrincipal(), nil
 	}
@@ -27,12 +28,12 @@ func (s *StubPrincipalRepository) GetByID(id domain.SubjectID) (domain.Principal
 }
 
 // GetByIDs is a bulk version of GetByID to allow the underlying implementation to optimize access to sets of principals and should otherwise have the same behavior.
-func (s *StubPrincipalRepository) GetByIDs(ids []domain.SubjectID) ([]domain.Principal, error) {
+func (s *StubPrincipalRepository) GetByIDs(ctx context.Context, ids []domain.SubjectID) ([]domain.Principal, error) {
 	principals := make([]domain.Principal, len(ids))
 
 	for i, id := range ids {
 		var err error
-		if principals[i], err = s.GetByID(id); err != nil {
+		if principals[i], err = s.GetByID(ctx, id); err != nil {
 			return nil, err
 		}
 	}
@@ -40,8 +41,18 @@ func (s *StubPrincipalRepository) GetByIDs(ids []domain.SubjectID) ([]domain.Pri
 	return principals, nil
 }
 
+// GetPageByIDs retrieves the principals with the given IDs that match the query, in the order of the query and at most as many as its limit
+func (s *StubPrincipalRepository) GetPageByIDs(ctx context.Context, ids []domain.SubjectID, query domain.PrincipalQuery) ([]domain.Principal, error) {
+	principals, err := s.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return query.Apply(principals), nil
+}
+
 // GetByOrgID retrieves all members of the given organization
-func (s *StubPrincipalRepository) GetByOrgID(orgID string) (chan domain.Subject, chan error) {
+func (s *StubPrincipalRepository) GetByOrgID(ctx context.Context, orgID string) (chan domain.Subject, chan error) {
 	subjects := make(chan domain.Subject)
 	errors := make(chan error)
 
@@ -5,7 +5,9 @@ import (
 	"authz/bootstrap/serviceconfig"
 	"authz/domain"
 	"authz/domain/contracts"
+	"authz/infrastructure/metrics"
 	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -15,18 +17,27 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/golang/glog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
-	sortBy           = "principal"
-	defaultPageSize  = 20
-	defaultSortOrder = true
+	sortBy            = "principal"
+	defaultPageSize   = 20
+	defaultSortOrder  = true
+	userDataBatchSize = 100 // users requested at once when looking up pages of users, whose order the user service cannot apply to a set of IDs
 
 	assumeNextPageAvailableByDefaultIfError = true // when retrieving a page of users and there is an error, should we still assume another page exists
 )
 
+var tracer = otel.Tracer("authz/infrastructure/repository/userservice")
+
 // SubjectRepository defines a repository that queries a user service using json requests of the type defined in userServiceSubjectByOrgRequest
 type SubjectRepository struct {
 	URL        url.URL
@@ -135,7 +146,7 @@ type userServiceUserDataResponse []struct {
 }
 
 // GetByOrgID retrieves all members of the given organization
-func (u *SubjectRepository) GetByOrgID(orgID string) (chan domain.Subject, chan error) {
+func (u *SubjectRepository) GetByOrgID(ctx context.Context, orgID string) (chan domain.Subject, chan error) {
 	subChan := make(chan domain.Subject)
 	errChan := make(chan error)
 
@@ -157,7 +168,7 @@ func (u *SubjectRepository) GetByOrgID(orgID string) (chan domain.Subject, chan
 		shouldFetchPage := true
 
 		for page := 0; shouldFetchPage; page++ {
-			nextPageIsAvailable, serviceCallErr, pageProcessingErr := u.fetchPageOfUsers(orgID, page, subChan, errChan)
+			nextPageIsAvailable, serviceCallErr, pageProcessingErr := u.fetchPageOfUsers(ctx, orgID, page, subChan, errChan)
 
 			shouldFetchPage = shouldFetchNextPage(nextPageIsAvailable, serviceCallErr, pageProcessingErr)
 
@@ -171,8 +182,8 @@ func (u *SubjectRepository) GetByOrgID(orgID string) (chan domain.Subject, chan
 }
 
 // GetByID retrieves a principal for the given ID. If no ID is provided (ex: empty string), it returns an anonymous principal. If any error occurs, it's returned.
-func (u *SubjectRepository) GetByID(id domain.SubjectID) (principal domain.Principal, err error) {
-	principals, err := u.GetByIDs([]domain.SubjectID{id})
+func (u *SubjectRepository) GetByID(ctx context.Context, id domain.SubjectID) (principal domain.Principal, err error) {
+	principals, err := u.GetByIDs(ctx, []domain.SubjectID{id})
 
 	if err == nil && len(principals) > 0 {
 		principal = principals[0]
@@ -182,10 +193,13 @@ func (u *SubjectRepository) GetByID(id domain.SubjectID) (principal domain.Princ
 }
 
 // GetByIDs is a bulk version of GetByID to allow the underlying implementation to optimize access to sets of principals and should otherwise have the same behavior.
-func (u *SubjectRepository) GetByIDs(ids []domain.SubjectID) (principals []domain.Principal, err error) {
+func (u *SubjectRepository) GetByIDs(ctx context.Context, ids []domain.SubjectID) (principals []domain.Principal, err error) {
+	ctx, span := tracer.Start(ctx, "UserService.GetByIDs", trace.WithAttributes(attribute.Int("authz.user_count", len(ids))))
+	defer func() { endSpan(span, err) }()
+
 	req := u.makeUserServiceUserDataRequest(ids)
 
-	resp, err := u.doUserServiceUserDataCall(req)
+	resp, err := u.doUserServiceUserDataCall(ctx, req)
 
 	if err != nil {
 		return
@@ -202,6 +216,43 @@ func (u *SubjectRepository) GetByIDs(ids []domain.SubjectID) (principals []domai
 	return
 }
 
+// GetPageByIDs retrieves the principals with the given IDs that match the query, in the order of the query and at most as many as its limit.
+// The user service cannot search, sort or page a set of IDs, so the users are requested in batches and only the best matches so far are kept.
+func (u *SubjectRepository) GetPageByIDs(ctx context.Context, ids []domain.SubjectID, query domain.PrincipalQuery) (page []domain.Principal, err error) {
+	ctx, span := tracer.Start(ctx, "UserService.GetPageByIDs", trace.WithAttributes(attribute.Int("authz.user_count", len(ids)), attribute.Int("authz.limit", query.Limit)))
+	defer func() { endSpan(span, err) }()
+
+	page = []domain.Principal{}
+	for start := 0; start < len(ids); start += userDataBatchSize {
+		end := start + userDataBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		batch, err := u.GetByIDs(ctx, ids[start:end])
+		if err != nil {
+			return nil, err
+		}
+		page = query.Apply(append(page, batch...))
+	}
+
+	return page, nil
+}
+
+// Ping checks that the user service answers a request for no users
+func (u *SubjectRepository) Ping(ctx context.Context) error {
+	req := userServiceUserDataRequest{}
+	req.By.UserIds = []string{}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	_, err = u.doUserServiceCall(ctx, "Ping", body, nil, false)
+	return err
+}
+
 func getUsername(authns []authenticationData) string {
 	if len(authns) > 0 {
 		return authns[0].Principal //Look for specific provider?
@@ -242,10 +293,15 @@ func (u *SubjectRepository) makeUserServiceUserDataRequest(subjectIDs []domain.S
 	return req
 }
 
-func (u *SubjectRepository) fetchPageOfUsers(orgID string, currentPage int, subChan chan domain.Subject, errChan chan error) (bool, error, error) {
+func (u *SubjectRepository) fetchPageOfUsers(ctx context.Context, orgID string, currentPage int, subChan chan domain.Subject, errChan chan error) (bool, error, error) {
+	ctx, span := tracer.Start(ctx, "UserService.GetByOrgID page", trace.WithAttributes(
+		attribute.String("authz.org_id", orgID), attribute.Int("authz.page", currentPage)))
+
 	req := u.makeUserServiceSubjectByOrgRequest(orgID, currentPage*u.Paging.PageSize)
 
-	resp, nextPageAvailable, serviceCallErr := u.doPagedUserServiceCall(req, errChan)
+	resp, nextPageAvailable, serviceCallErr := u.doPagedUserServiceCall(ctx, req, errChan)
+	span.SetAttributes(attribute.Int("authz.user_count", len(resp)), attribute.Bool("authz.next_page_available", nextPageAvailable))
+	endSpan(span, serviceCallErr)
 
 	var pageProcessingErr error
 	if resp != nil {
@@ -255,7 +311,15 @@ func (u *SubjectRepository) fetchPageOfUsers(orgID string, currentPage int, subC
 	return nextPageAvailable, serviceCallErr, pageProcessingErr
 }
 
-func (u *SubjectRepository) doPagedUserServiceCall(req userServiceSubjectByOrgRequest, errChan chan error) (userServiceSubjectByOrgResponse, bool, error) {
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (u *SubjectRepository) doPagedUserServiceCall(ctx context.Context, req userServiceSubjectByOrgRequest, errChan chan error) (userServiceSubjectByOrgResponse, bool, error) {
 	// Step 1: marshall the userServiceSubjectByOrgRequest
 	userRepositoryRequestJSON, err := json.Marshal(req)
 
@@ -266,7 +330,7 @@ func (u *SubjectRepository) doPagedUserServiceCall(req userServiceSubjectByOrgRe
 	}
 
 	// Step 2: POST the request using the configured repository http client and url
-	body, err := u.doUserServiceCall(userRepositoryRequestJSON, errChan, true)
+	body, err := u.doUserServiceCall(ctx, "GetByOrgID", userRepositoryRequestJSON, errChan, true)
 	if err != nil {
 		return nil, assumeNextPageAvailableByDefaultIfError, err
 	}
@@ -291,14 +355,14 @@ func (u *SubjectRepository) doPagedUserServiceCall(req userServiceSubjectByOrgRe
 	return userResponses, nextPageAvailable, err
 }
 
-func (u *SubjectRepository) doUserServiceUserDataCall(req userServiceUserDataRequest) (userServiceUserDataResponse, error) {
+func (u *SubjectRepository) doUserServiceUserDataCall(ctx context.Context, req userServiceUserDataRequest) (userServiceUserDataResponse, error) {
 	userServiceUserDataRequestJSON, err := json.Marshal(req)
 	var userServiceUserDataResponses userServiceUserDataResponse
 
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling userServiceUserDataRequest: %v: %w", req, err)
 	}
-	body, err := u.doUserServiceCall(userServiceUserDataRequestJSON, nil, false)
+	body, err := u.doUserServiceCall(ctx, "GetByIDs", userServiceUserDataRequestJSON, nil, false)
 	if err != nil {
 		return nil, err
 	}
@@ -309,8 +373,23 @@ func (u *SubjectRepository) doUserServiceUserDataCall(req userServiceUserDataReq
 	return userServiceUserDataResponses, nil
 }
 
-func (u *SubjectRepository) doUserServiceCall(reqBody []byte, errChan chan error, useErrChan bool) (respBody []byte, err error) {
-	resp, err := u.HTTPClient.Post(u.URL.String(), "application/json", bytes.NewBuffer(reqBody))
+func (u *SubjectRepository) doUserServiceCall(ctx context.Context, operation string, reqBody []byte, errChan chan error, useErrChan bool) (respBody []byte, err error) {
+	defer func(start time.Time) {
+		metrics.ObserveUserServiceCall(operation, start, err)
+	}(time.Now())
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, u.URL.String(), bytes.NewBuffer(reqBody))
+	if err != nil {
+		if useErrChan {
+			errChan <- err
+		}
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	// The user service may continue the trace of the request
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(httpReq.Header))
+
+	resp, err := u.HTTPClient.Do(httpReq)
 
 	if err != nil {
 		err = fmt.Errorf("failed to POST to UserService: %v: %w", u.URL, err)
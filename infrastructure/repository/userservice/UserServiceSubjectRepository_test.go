@@ -5,6 +5,7 @@ import (
 	"authz/domain"
 	"authz/domain/contracts"
 	"authz/testenv"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -37,7 +38,7 @@ func TestUserServiceSubjectRepository_get_single_page(t *testing.T) {
 	repo := createSubjectRepository(srv.Server)
 
 	//When
-	subjects, errors := repo.GetByOrgID(OrgID)
+	subjects, errors := repo.GetByOrgID(context.Background(), OrgID)
 
 	//Then
 	assertSuccessfulRequest(t, subjects, errors, expectedSubjects)
@@ -61,7 +62,7 @@ func TestUserServiceSubjectRepository_get_single_page_exact_pagesize(t *testing.
 	repo := createSubjectRepository(srv.Server)
 
 	//When
-	subjects, errors := repo.GetByOrgID(OrgID)
+	subjects, errors := repo.GetByOrgID(context.Background(), OrgID)
 
 	//Then
 	assertSuccessfulRequest(t, subjects, errors, expectedSubjects)
@@ -90,7 +91,7 @@ func TestUserServiceSubjectRepository_get_two_pages_one_item_on_second(t *testin
 	repo := createSubjectRepository(srv.Server)
 
 	//When
-	subjects, errors := repo.GetByOrgID(OrgID)
+	subjects, errors := repo.GetByOrgID(context.Background(), OrgID)
 
 	//Then
 	assertSuccessfulRequest(t, subjects, errors, expectedSubjects)
@@ -123,7 +124,7 @@ func TestUserServiceSubjectRepository_get_two_full_pages(t *testing.T) {
 	repo := createSubjectRepository(srv.Server)
 
 	//When
-	subjects, errors := repo.GetByOrgID(OrgID)
+	subjects, errors := repo.GetByOrgID(context.Background(), OrgID)
 
 	//Then
 	assertSuccessfulRequest(t, subjects, errors, expectedSubjects)
@@ -136,13 +137,61 @@ func TestUserServiceSubjectRepository_error_on_first_request(t *testing.T) {
 
 	repo := createSubjectRepository(srv.Server)
 
-	_, errors := repo.GetByOrgID(OrgID)
+	_, errors := repo.GetByOrgID(context.Background(), OrgID)
 
 	err := <-errors
 
 	assert.Error(t, err)
 }
 
+func TestUserServiceSubjectRepository_ping(t *testing.T) {
+	srv := testenv.HostFakeUserServiceAPI(t, []domain.Subject{}, OrgID, map[int]int{}, CertDirectory)
+	defer srv.Server.Close()
+
+	repo := createSubjectRepository(srv.Server).(*SubjectRepository)
+
+	assert.NoError(t, repo.Ping(context.Background()))
+}
+
+func TestUserServiceSubjectRepository_ping_error(t *testing.T) {
+	srv := testenv.HostFakeUserServiceAPI(t, []domain.Subject{}, OrgID, map[int]int{0: http.StatusServiceUnavailable}, CertDirectory)
+	defer srv.Server.Close()
+
+	repo := createSubjectRepository(srv.Server).(*SubjectRepository)
+
+	assert.Error(t, repo.Ping(context.Background()))
+}
+
+func TestUserServiceSubjectRepository_get_page_by_ids_across_batches(t *testing.T) {
+	subjects := make([]domain.Subject, 250)
+	ids := make([]domain.SubjectID, len(subjects))
+	for i := range subjects {
+		ids[i] = domain.SubjectID(fmt.Sprint(i))
+		subjects[i] = domain.Subject{SubjectID: ids[i], Enabled: true}
+	}
+	srv := testenv.HostFakeUserServiceAPI(t, subjects, OrgID, map[int]int{}, CertDirectory)
+	defer srv.Server.Close()
+
+	repo := createSubjectRepository(srv.Server).(*SubjectRepository)
+
+	page, err := repo.GetPageByIDs(context.Background(), ids, domain.PrincipalQuery{SortBy: domain.PrincipalSortByUsername, Search: "user_2", Limit: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SubjectID{"2", "20", "200"}, principalIDs(page))
+
+	after := domain.CursorOf(page[2], domain.PrincipalSortByUsername)
+	page, err = repo.GetPageByIDs(context.Background(), ids, domain.PrincipalQuery{SortBy: domain.PrincipalSortByUsername, Search: "user_2", After: &after, Limit: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SubjectID{"201", "202", "203"}, principalIDs(page))
+}
+
+func principalIDs(principals []domain.Principal) []domain.SubjectID {
+	ids := make([]domain.SubjectID, len(principals))
+	for i, p := range principals {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
 func createSubjectRepository(srv *httptest.Server) contracts.SubjectRepository {
 	config := serviceconfig.UserServiceConfig{
 		URL:                       fmt.Sprintf("%s/v2/findUsers", srv.URL),
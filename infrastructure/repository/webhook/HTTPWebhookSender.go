@@ -0,0 +1,106 @@
+// Package webhook delivers license events to the HTTPS endpoints organizations registered
+package webhook
+
+import (
+	"authz/bootstrap/serviceconfig"
+	"authz/domain"
+	"authz/infrastructure/repository/messaging"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 signature of the request body, keyed with the webhook secret, as "sha256=<hex>"
+	SignatureHeader = "X-Authz-Signature-256"
+	// EventTypeHeader carries the type of the delivered license event
+	EventTypeHeader = "X-Authz-Event"
+)
+
+// HTTPWebhookSender posts license events as signed JSON to webhooks. The payload has the same format as the license event messages published to the UMB.
+type HTTPWebhookSender struct {
+	client *http.Client
+}
+
+// NewHTTPWebhookSender constructs a new HTTPWebhookSender sending requests with the given client
+func NewHTTPWebhookSender(client *http.Client) *HTTPWebhookSender {
+	return &HTTPWebhookSender{client: client}
+}
+
+// NewHTTPWebhookSenderFromConfig constructs a new HTTPWebhookSender applying the timeout of the config to each attempt. It only connects to public addresses and never through a proxy, whose address would be checked instead of the webhook's.
+// Redirects are not followed, they could lead to a plain HTTP URL, which webhooks must not have. A redirect response fails the attempt.
+func NewHTTPWebhookSenderFromConfig(config serviceconfig.WebhookConfig) *HTTPWebhookSender {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: publicAddressesOnly}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return NewHTTPWebhookSender(&http.Client{
+		Transport:     transport,
+		Timeout:       time.Second * time.Duration(config.TimeoutSeconds),
+		CheckRedirect: refuseRedirects,
+	})
+}
+
+// refuseRedirects makes the client return redirect responses instead of following them
+func refuseRedirects(*http.Request, []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+// publicAddressesOnly refuses connections to addresses internal to the deployment. It runs after the host of a webhook was resolved, so a host resolving differently than at registration cannot reach them either.
+func publicAddressesOnly(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if !domain.IsPublicWebhookAddress(net.ParseIP(host)) {
+		return fmt.Errorf("refusing to connect to non-public address %s", host)
+	}
+
+	return nil
+}
+
+// Send posts a single event to the webhook. Any 2xx response is considered as accepted.
+func (s *HTTPWebhookSender) Send(ctx context.Context, webhook domain.Webhook, evt domain.LicenseEvent) (int, error) {
+	body, err := messaging.NewLicenseEventMessage(evt, time.Now()).Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, string(evt.Type))
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body) // allows reusing the connection
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign computes the value of the SignatureHeader for the given body. Receivers verify deliveries by comparing it to the received header.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"authz/bootstrap/serviceconfig"
+	"authz/domain"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendPostsSignedEvent(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer receiver.Close()
+
+	sender := NewHTTPWebhookSender(receiver.Client())
+	webhook := domain.Webhook{ID: "w1", OrgID: "o1", URL: receiver.URL + "/hook", Secret: "s3cr3t"}
+
+	status, err := sender.Send(context.Background(), webhook, domain.LicenseEvent{Type: domain.SeatAssigned, OrgID: "o1", ServiceID: "smarts", SubjectID: "u1", SeatsTotal: 10, SeatsAvailable: 7})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, status)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "/hook", received.URL.Path)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, "SeatAssigned", received.Header.Get(EventTypeHeader))
+	assert.Equal(t, Sign("s3cr3t", body), received.Header.Get(SignatureHeader))
+	assert.NotEqual(t, Sign("other", body), received.Header.Get(SignatureHeader))
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, "SeatAssigned", payload["eventType"])
+	assert.Equal(t, "o1", payload["orgId"])
+	assert.Equal(t, "smarts", payload["serviceId"])
+	assert.Equal(t, "u1", payload["subjectId"])
+	assert.Equal(t, float64(7), payload["seatsAvailable"])
+}
+
+func TestSendFailsOnErrorStatus(t *testing.T) {
+	receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+
+	sender := NewHTTPWebhookSender(receiver.Client())
+
+	status, err := sender.Send(context.Background(), domain.Webhook{URL: receiver.URL, Secret: "s3cr3t"}, domain.LicenseEvent{Type: domain.LicenseEntitled})
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+}
+
+func TestSendFailsIfReceiverIsUnreachable(t *testing.T) {
+	receiver := httptest.NewTLSServer(http.NotFoundHandler())
+	sender := NewHTTPWebhookSender(receiver.Client())
+	receiver.Close()
+
+	status, err := sender.Send(context.Background(), domain.Webhook{URL: receiver.URL, Secret: "s3cr3t"}, domain.LicenseEvent{Type: domain.LicenseEntitled})
+
+	assert.Error(t, err)
+	assert.Equal(t, 0, status)
+}
+
+func TestSenderFromConfigRefusesInternalAddresses(t *testing.T) {
+	requested := false
+	receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer receiver.Close()
+
+	sender := NewHTTPWebhookSenderFromConfig(serviceconfig.WebhookConfig{TimeoutSeconds: 1})
+
+	status, err := sender.Send(context.Background(), domain.Webhook{URL: receiver.URL, Secret: "s3cr3t"}, domain.LicenseEvent{Type: domain.LicenseEntitled})
+
+	assert.ErrorContains(t, err, "non-public address 127.0.0.1")
+	assert.Equal(t, 0, status)
+	assert.False(t, requested)
+}
+
+func TestSenderFromConfigDoesNotFollowRedirects(t *testing.T) {
+	sender := NewHTTPWebhookSenderFromConfig(serviceconfig.WebhookConfig{TimeoutSeconds: 1})
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer receiver.Close()
+
+	// The test servers listen on loopback addresses, which the sender from config refuses, so its redirect policy is applied to a test client
+	client := receiver.Client()
+	client.CheckRedirect = sender.client.CheckRedirect
+
+	status, err := NewHTTPWebhookSender(client).Send(context.Background(), domain.Webhook{URL: receiver.URL, Secret: "s3cr3t"}, domain.LicenseEvent{Type: domain.LicenseEntitled})
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusTemporaryRedirect, status)
+	assert.False(t, redirected)
+}
+
+func TestSendIsCanceledWithItsContext(t *testing.T) {
+	release := make(chan struct{})
+	receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer receiver.Close()
+	defer close(release)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	status, err := NewHTTPWebhookSender(receiver.Client()).Send(ctx, domain.Webhook{URL: receiver.URL, Secret: "s3cr3t"}, domain.LicenseEvent{Type: domain.LicenseEntitled})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 0, status)
+}
+
+func TestSignIsHexEncodedHMACSHA256(t *testing.T) {
+	// Reference value computed with: echo -n '{}' | openssl dgst -sha256 -hmac key
+	assert.Equal(t, "sha256=a777724d943eb48dc69bca8a4a6d57a04db3f9ec7e1de4e581e860265bdf3032", Sign("key", []byte("{}")))
+}
@@ -0,0 +1,361 @@
+// Package snapshot exports and imports the authorization state of a store as relationships in the SpiceDB bootstrap format
+package snapshot
+
+import (
+	"authz/infrastructure/repository/authzed/migrations"
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FormatVersion is the version of the snapshot file format written by Export
+const FormatVersion = 1
+
+// Format is the encoding of a snapshot file
+type Format string
+
+const (
+	// YAML - the format of schema/spicedb_bootstrap_relations.yaml
+	YAML Format = "yaml"
+	// JSON - the same fields as YAML
+	JSON Format = "json"
+)
+
+// ResourceTypes are the types of the relationships in a snapshot, in the order they are written: licenses with their seat assignments, the members, disabled tombstones and admins of orgs and the license managers of services
+var ResourceTypes = []string{"license", "license_seats", "seat", "org", "service"}
+
+// Store reads and writes the relationships of a store
+type Store interface {
+	// ReadRelationships returns all relationships of the resource types as of a single revision of the store, so that changes made while reading are either complete or missing, and that revision. The revision is empty if the store has none.
+	ReadRelationships(ctx context.Context, resourceTypes []string) ([]Relationship, string, error)
+	// UpdateRelationships creates the relationships to touch unless they exist and deletes the relationships to remove
+	UpdateRelationships(ctx context.Context, touch []Relationship, remove []Relationship) error
+}
+
+// Relationship is a single tuple in the form resourceType:resourceID#relation@subjectType:subjectID[#subjectRelation]
+type Relationship struct {
+	ResourceType    string
+	ResourceID      string
+	Relation        string
+	SubjectType     string
+	SubjectID       string
+	SubjectRelation string // optional
+}
+
+func (r Relationship) String() string {
+	s := fmt.Sprintf("%s:%s#%s@%s:%s", r.ResourceType, r.ResourceID, r.Relation, r.SubjectType, r.SubjectID)
+	if r.SubjectRelation != "" {
+		s += "#" + r.SubjectRelation
+	}
+	return s
+}
+
+// ParseRelationship parses a relationship in the form resourceType:resourceID#relation@subjectType:subjectID[#subjectRelation]
+func ParseRelationship(line string) (Relationship, error) {
+	resource, subject, ok := strings.Cut(line, "@")
+	if !ok {
+		return Relationship{}, fmt.Errorf("invalid relationship %s: missing subject", line)
+	}
+
+	object, relation, ok := strings.Cut(resource, "#")
+	if !ok || relation == "" {
+		return Relationship{}, fmt.Errorf("invalid relationship %s: missing relation", line)
+	}
+
+	resourceType, resourceID, ok := strings.Cut(object, ":")
+	if !ok || resourceType == "" || resourceID == "" {
+		return Relationship{}, fmt.Errorf("invalid relationship %s: malformed resource", line)
+	}
+
+	subject, subjectRelation, _ := strings.Cut(subject, "#")
+	subjType, subjectID, ok := strings.Cut(subject, ":")
+	if !ok || subjType == "" || subjectID == "" {
+		return Relationship{}, fmt.Errorf("invalid relationship %s: malformed subject", line)
+	}
+
+	return Relationship{resourceType, resourceID, relation, subjType, subjectID, subjectRelation}, nil
+}
+
+// Snapshot is the authorization state of a store. Its relationships are in the format of schema/spicedb_bootstrap_relations.yaml, so that snapshots can be loaded into SpiceDB directly and the bootstrap relationships can be imported as snapshot.
+type Snapshot struct {
+	Version       int       `json:"version" yaml:"version"`                                 // the format version, 0 for plain bootstrap relationship files
+	SchemaVersion int       `json:"schemaVersion,omitempty" yaml:"schemaVersion,omitempty"` // the schema version of the relationships, see migrations.Version
+	CreatedAt     time.Time `json:"createdAt" yaml:"createdAt,omitempty"`
+	Revision      string    `json:"revision,omitempty" yaml:"revision,omitempty"` // the store revision the relationships were read at, a ZedToken for SpiceDB
+	Relationships string    `json:"relationships" yaml:"relationships"`           // one relationship per line, // comments allowed
+}
+
+// ImportResult counts the changes of an import
+type ImportResult struct {
+	Created int // relationships of the snapshot that did not exist
+	Removed int // relationships of licenses of the snapshot that do not exist in the snapshot
+}
+
+// Changes are the updates that import a snapshot into a store
+type Changes struct {
+	Touch  []Relationship // relationships of the snapshot that do not exist
+	Remove []Relationship // relationships of licenses of the snapshot that do not exist in the snapshot
+}
+
+// Export reads all relationships of the ResourceTypes from the store at a single revision
+func Export(ctx context.Context, store Store) (Snapshot, error) {
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	rels, revision, err := store.ReadRelationships(ctx, ResourceTypes)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	linesByType := make(map[string][]string)
+	for _, rel := range rels {
+		linesByType[rel.ResourceType] = append(linesByType[rel.ResourceType], rel.String())
+	}
+
+	var lines []string
+	for _, resourceType := range ResourceTypes {
+		typeLines := linesByType[resourceType]
+		sort.Strings(typeLines)
+		lines = append(lines, typeLines...)
+	}
+
+	return Snapshot{
+		Version:       FormatVersion,
+		SchemaVersion: migrations.Latest().Number,
+		CreatedAt:     createdAt,
+		Revision:      revision,
+		Relationships: strings.Join(lines, "\n"),
+	}, nil
+}
+
+// Import writes the relationships of the snapshot to the store. Licenses of the snapshot replace the licenses of the store, i.e. their relationships not in the snapshot, like other seat assignments, are removed.
+// All other relationships of the store are kept, so importing a snapshot again changes nothing.
+func Import(ctx context.Context, store Store, snapshot Snapshot) (ImportResult, error) {
+	changes, err := Diff(ctx, store, snapshot)
+	if err != nil {
+		return ImportResult{}, err
+	}
+
+	if changes.Empty() {
+		return ImportResult{}, nil
+	}
+	if err := store.UpdateRelationships(ctx, changes.Touch, changes.Remove); err != nil {
+		return ImportResult{}, err
+	}
+
+	return ImportResult{Created: len(changes.Touch), Removed: len(changes.Remove)}, nil
+}
+
+// Diff returns the changes Import would make to the store, without making them
+func Diff(ctx context.Context, store Store, snapshot Snapshot) (Changes, error) {
+	if snapshot.Version > FormatVersion {
+		return Changes{}, fmt.Errorf("snapshot format version %d is newer than the supported version %d", snapshot.Version, FormatVersion)
+	}
+	if latest := migrations.Latest(); snapshot.SchemaVersion > latest.Number {
+		return Changes{}, fmt.Errorf("snapshot schema version %d is newer than the latest version %s", snapshot.SchemaVersion, latest)
+	}
+
+	rels, err := snapshot.ParseRelationships()
+	if err != nil {
+		return Changes{}, err
+	}
+
+	wanted := make(map[Relationship]bool, len(rels))
+	licenses := make(map[string]bool)
+	for _, rel := range rels {
+		wanted[rel] = true
+		if rel.ResourceType == "license" || rel.ResourceType == "license_seats" {
+			licenses[rel.ResourceID] = true
+		}
+	}
+
+	current, _, err := store.ReadRelationships(ctx, ResourceTypes)
+	if err != nil {
+		return Changes{}, err
+	}
+
+	var changes Changes
+	existing := make(map[Relationship]bool, len(current))
+	for _, rel := range current {
+		existing[rel] = true
+		if !wanted[rel] && licenses[licenseOf(rel)] {
+			changes.Remove = append(changes.Remove, rel)
+		}
+	}
+
+	for _, rel := range rels {
+		if !existing[rel] {
+			changes.Touch = append(changes.Touch, rel)
+		}
+	}
+
+	return changes, nil
+}
+
+// Empty returns true if there is nothing to change
+func (c Changes) Empty() bool {
+	return len(c.Touch) == 0 && len(c.Remove) == 0
+}
+
+// Batches splits the changes into batches of at most size relationships. The changes of a license are kept in the same batch, unless they exceed size on their own, so that a failed import does not leave a license half replaced.
+func (c Changes) Batches(size int) []Changes {
+	var order []string
+	byLicense := make(map[string]*Changes)
+	group := func(rel Relationship) *Changes {
+		key := licenseOf(rel)
+		if key == "" {
+			key = rel.String() // relationships of no license are independent
+		}
+		changes, ok := byLicense[key]
+		if !ok {
+			changes = &Changes{}
+			byLicense[key] = changes
+			order = append(order, key)
+		}
+		return changes
+	}
+	for _, rel := range c.Remove {
+		changes := group(rel)
+		changes.Remove = append(changes.Remove, rel)
+	}
+	for _, rel := range c.Touch {
+		changes := group(rel)
+		changes.Touch = append(changes.Touch, rel)
+	}
+
+	var batches []Changes
+	var batch Changes
+	for _, key := range order {
+		changes := *byLicense[key]
+		if batch.size()+changes.size() > size && !batch.Empty() {
+			batches = append(batches, batch)
+			batch = Changes{}
+		}
+		for changes.size() > size {
+			// The license alone exceeds a batch
+			batches = append(batches, changes.take(size))
+		}
+		batch.Remove = append(batch.Remove, changes.Remove...)
+		batch.Touch = append(batch.Touch, changes.Touch...)
+	}
+	if !batch.Empty() {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+func (c Changes) size() int {
+	return len(c.Touch) + len(c.Remove)
+}
+
+// take removes the first n changes, removals first, and returns them
+func (c *Changes) take(n int) Changes {
+	var taken Changes
+	removed := n
+	if removed > len(c.Remove) {
+		removed = len(c.Remove)
+	}
+	taken.Remove, c.Remove = c.Remove[:removed], c.Remove[removed:]
+	touched := n - removed
+	if touched > len(c.Touch) {
+		touched = len(c.Touch)
+	}
+	taken.Touch, c.Touch = c.Touch[:touched], c.Touch[touched:]
+
+	return taken
+}
+
+// ParseRelationships parses the relationships of the snapshot, skipping comments and empty lines. Duplicates are returned once.
+func (s Snapshot) ParseRelationships() ([]Relationship, error) {
+	var rels []Relationship
+	seen := make(map[Relationship]bool)
+
+	scanner := bufio.NewScanner(strings.NewReader(s.Relationships))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		rel, err := ParseRelationship(line)
+		if err != nil {
+			return nil, err
+		}
+		if !isSnapshotType(rel.ResourceType) {
+			return nil, fmt.Errorf("invalid relationship %s: resource type %s is not part of a snapshot", line, rel.ResourceType)
+		}
+
+		if !seen[rel] {
+			seen[rel] = true
+			rels = append(rels, rel)
+		}
+	}
+
+	return rels, scanner.Err()
+}
+
+// FormatOf returns the format of a snapshot file by its extension: JSON for .json files, YAML otherwise
+func FormatOf(path string) Format {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return JSON
+	}
+	return YAML
+}
+
+// Write encodes the snapshot in the given format
+func (s Snapshot) Write(w io.Writer, format Format) error {
+	if format == JSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(s)
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(s); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// Read decodes a snapshot in the given format
+func Read(r io.Reader, format Format) (Snapshot, error) {
+	var snapshot Snapshot
+	var err error
+	if format == JSON {
+		err = json.NewDecoder(r).Decode(&snapshot)
+	} else {
+		err = yaml.NewDecoder(r).Decode(&snapshot)
+	}
+
+	return snapshot, err
+}
+
+// licenseOf returns the ID of the license a relationship belongs to, empty if it belongs to none. Seat IDs are <orgID>/<serviceID>/<subjectID>.
+func licenseOf(rel Relationship) string {
+	switch rel.ResourceType {
+	case "license", "license_seats":
+		return rel.ResourceID
+	case "seat":
+		if i := strings.LastIndex(rel.ResourceID, "/"); i > 0 {
+			return rel.ResourceID[:i]
+		}
+	}
+	return ""
+}
+
+func isSnapshotType(resourceType string) bool {
+	for _, t := range ResourceTypes {
+		if t == resourceType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,281 @@
+package snapshot
+
+import (
+	"authz/infrastructure/repository/authzed/migrations"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeStore struct {
+	rels    map[Relationship]bool
+	updates int
+}
+
+func newFakeStore(lines ...string) *fakeStore {
+	store := &fakeStore{rels: map[Relationship]bool{}}
+	for _, line := range lines {
+		rel, err := ParseRelationship(line)
+		if err != nil {
+			panic(err)
+		}
+		store.rels[rel] = true
+	}
+	return store
+}
+
+func (f *fakeStore) ReadRelationships(_ context.Context, resourceTypes []string) ([]Relationship, string, error) {
+	var result []Relationship
+	for _, resourceType := range resourceTypes {
+		for rel := range f.rels {
+			if rel.ResourceType == resourceType {
+				result = append(result, rel)
+			}
+		}
+	}
+	return result, fmt.Sprintf("r%d", f.updates), nil
+}
+
+func (f *fakeStore) UpdateRelationships(_ context.Context, touch []Relationship, remove []Relationship) error {
+	f.updates++
+	for _, rel := range remove {
+		delete(f.rels, rel)
+	}
+	for _, rel := range touch {
+		f.rels[rel] = true
+	}
+	return nil
+}
+
+func (f *fakeStore) lines() []string {
+	var result []string
+	for rel := range f.rels {
+		result = append(result, rel.String())
+	}
+	return result
+}
+
+func TestRelationshipsAreParsedAndFormatted(t *testing.T) {
+	for _, line := range []string{"license:o1/smarts#max@max:10", "org:o1#member@user:u1", "service:smarts#licensed@license:o1/smarts", "org:o1#viewer@group:g1#member"} {
+		rel, err := ParseRelationship(line)
+		assert.NoError(t, err)
+		assert.Equal(t, line, rel.String())
+	}
+
+	for _, line := range []string{"license:o1/smarts#max", "license:o1/smarts@max:10", "license#max@max:10", "license:o1/smarts#max@10"} {
+		_, err := ParseRelationship(line)
+		assert.Error(t, err, line)
+	}
+}
+
+func TestExportWritesSnapshotRelationshipsOrderedByType(t *testing.T) {
+	store := newFakeStore(
+		"org:o1#member@user:u2",
+		"org:o1#member@user:u1",
+		"license_seats:o1/smarts#assigned@user:u1",
+		"license:o1/smarts#max@max:10",
+		"outbox_event:e1#payload@outbox_payload:abc",
+	)
+
+	snapshot, err := Export(context.Background(), store)
+
+	assert.NoError(t, err)
+	assert.Equal(t, FormatVersion, snapshot.Version)
+	assert.Equal(t, migrations.Latest().Number, snapshot.SchemaVersion)
+	assert.False(t, snapshot.CreatedAt.IsZero())
+	assert.Equal(t, "r0", snapshot.Revision)
+	assert.Equal(t, "license:o1/smarts#max@max:10\nlicense_seats:o1/smarts#assigned@user:u1\norg:o1#member@user:u1\norg:o1#member@user:u2", snapshot.Relationships)
+}
+
+func TestImportIntoEmptyStoreCreatesAllRelationshipsAndIsIdempotent(t *testing.T) {
+	source := newFakeStore(
+		"license:o1/smarts#max@max:10",
+		"license:o1/smarts#version@version:abc/1",
+		"license_seats:o1/smarts#assigned@user:u1",
+		"seat:o1/smarts/u1#assigned_at@timestamp:1700000000",
+		"org:o1#member@user:u1",
+		"org:o1#disabled@user:u2",
+	)
+	snapshot, err := Export(context.Background(), source)
+	assert.NoError(t, err)
+	target := newFakeStore()
+
+	result, err := Import(context.Background(), target, snapshot)
+	assert.NoError(t, err)
+	assert.Equal(t, ImportResult{Created: 6}, result)
+	assert.ElementsMatch(t, source.lines(), target.lines())
+
+	result, err = Import(context.Background(), target, snapshot)
+	assert.NoError(t, err)
+	assert.Equal(t, ImportResult{}, result)
+	assert.Equal(t, 1, target.updates)
+}
+
+func TestImportReplacesLicensesOfSnapshotAndKeepsOtherRelationships(t *testing.T) {
+	target := newFakeStore(
+		"license:o1/smarts#max@max:5",
+		"license:o1/smarts#version@version:old/2",
+		"license_seats:o1/smarts#assigned@user:u1",
+		"license_seats:o1/smarts#assigned@user:u3",
+		"seat:o1/smarts/u3#assigned_at@timestamp:1600000000",
+		"license:o2/smarts#max@max:1",
+		"org:o1#member@user:u3",
+	)
+	snapshot := Snapshot{Version: FormatVersion, Relationships: strings.Join([]string{
+		"license:o1/smarts#max@max:10",
+		"license:o1/smarts#version@version:new/1",
+		"license_seats:o1/smarts#assigned@user:u1",
+		"org:o1#member@user:u1",
+	}, "\n")}
+
+	result, err := Import(context.Background(), target, snapshot)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ImportResult{Created: 3, Removed: 4}, result)
+	assert.ElementsMatch(t, []string{
+		"license:o1/smarts#max@max:10",
+		"license:o1/smarts#version@version:new/1",
+		"license_seats:o1/smarts#assigned@user:u1",
+		"license:o2/smarts#max@max:1",
+		"org:o1#member@user:u1",
+		"org:o1#member@user:u3",
+	}, target.lines())
+}
+
+func TestDiffReturnsChangesOfImportWithoutWriting(t *testing.T) {
+	target := newFakeStore(
+		"license:o1/smarts#max@max:5",
+		"license_seats:o1/smarts#assigned@user:u3",
+		"org:o1#member@user:u3",
+	)
+	snapshot := Snapshot{Version: FormatVersion, Relationships: strings.Join([]string{
+		"license:o1/smarts#max@max:10",
+		"license_seats:o1/smarts#assigned@user:u3",
+		"org:o1#member@user:u1",
+	}, "\n")}
+
+	changes, err := Diff(context.Background(), target, snapshot)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"license:o1/smarts#max@max:5"}, relationshipLines(changes.Remove))
+	assert.Equal(t, []string{"license:o1/smarts#max@max:10", "org:o1#member@user:u1"}, relationshipLines(changes.Touch))
+	assert.Zero(t, target.updates)
+}
+
+func TestBatchesKeepTheChangesOfALicenseTogether(t *testing.T) {
+	changes := Changes{
+		Remove: parseRelationships(t, "license:o1/smarts#max@max:5", "license_seats:o2/smarts#assigned@user:u2"),
+		Touch: parseRelationships(t,
+			"license:o1/smarts#max@max:10",
+			"license_seats:o1/smarts#assigned@user:u1",
+			"seat:o1/smarts/u1#assigned_at@timestamp:1700000000",
+			"license_seats:o2/smarts#assigned@user:u3",
+			"org:o1#member@user:u1",
+		),
+	}
+
+	batches := changes.Batches(4)
+
+	assert.Len(t, batches, 2)
+	assert.Equal(t, []string{"license:o1/smarts#max@max:5"}, relationshipLines(batches[0].Remove))
+	assert.Equal(t, []string{"license:o1/smarts#max@max:10", "license_seats:o1/smarts#assigned@user:u1", "seat:o1/smarts/u1#assigned_at@timestamp:1700000000"}, relationshipLines(batches[0].Touch))
+	assert.Equal(t, []string{"license_seats:o2/smarts#assigned@user:u2"}, relationshipLines(batches[1].Remove))
+	assert.Equal(t, []string{"license_seats:o2/smarts#assigned@user:u3", "org:o1#member@user:u1"}, relationshipLines(batches[1].Touch))
+}
+
+func TestBatchesSplitLicensesExceedingABatch(t *testing.T) {
+	changes := Changes{
+		Remove: parseRelationships(t, "license_seats:o1/smarts#assigned@user:u1"),
+		Touch: parseRelationships(t,
+			"license_seats:o1/smarts#assigned@user:u2",
+			"license_seats:o1/smarts#assigned@user:u3",
+			"license:o2/smarts#max@max:1",
+		),
+	}
+
+	batches := changes.Batches(2)
+
+	assert.Len(t, batches, 2)
+	assert.Equal(t, []string{"license_seats:o1/smarts#assigned@user:u1"}, relationshipLines(batches[0].Remove))
+	assert.Equal(t, []string{"license_seats:o1/smarts#assigned@user:u2"}, relationshipLines(batches[0].Touch))
+	assert.Empty(t, batches[1].Remove)
+	assert.Equal(t, []string{"license_seats:o1/smarts#assigned@user:u3", "license:o2/smarts#max@max:1"}, relationshipLines(batches[1].Touch))
+}
+
+func parseRelationships(t *testing.T, lines ...string) []Relationship {
+	rels := make([]Relationship, len(lines))
+	for i, line := range lines {
+		rel, err := ParseRelationship(line)
+		assert.NoError(t, err)
+		rels[i] = rel
+	}
+	return rels
+}
+
+func relationshipLines(rels []Relationship) []string {
+	lines := make([]string, len(rels))
+	for i, rel := range rels {
+		lines[i] = rel.String()
+	}
+	return lines
+}
+
+func TestImportRejectsUnsupportedSnapshots(t *testing.T) {
+	cases := []Snapshot{
+		{Version: FormatVersion + 1},
+		{Version: FormatVersion, SchemaVersion: migrations.Latest().Number + 1},
+		{Version: FormatVersion, Relationships: "license:o1/smarts#max"},
+		{Version: FormatVersion, Relationships: "outbox_event:e1#payload@outbox_payload:abc"},
+	}
+
+	for _, snapshot := range cases {
+		target := newFakeStore()
+		_, err := Import(context.Background(), target, snapshot)
+		assert.Error(t, err)
+		assert.Zero(t, target.updates)
+	}
+}
+
+func TestSnapshotIsWrittenAndReadAsYAMLOrJSON(t *testing.T) {
+	snapshot, err := Export(context.Background(), newFakeStore("license:o1/smarts#max@max:10", "org:o1#member@user:u1"))
+	assert.NoError(t, err)
+
+	for _, format := range []Format{YAML, JSON} {
+		out := &bytes.Buffer{}
+		assert.NoError(t, snapshot.Write(out, format))
+
+		read, err := Read(out, format)
+		assert.NoError(t, err)
+		assert.Equal(t, snapshot.Relationships, read.Relationships, format)
+		assert.Equal(t, snapshot.SchemaVersion, read.SchemaVersion, format)
+		assert.True(t, snapshot.CreatedAt.Equal(read.CreatedAt), format)
+	}
+
+	assert.Equal(t, JSON, FormatOf("backup/snapshot.JSON"))
+	assert.Equal(t, YAML, FormatOf("snapshot.yaml"))
+	assert.Equal(t, YAML, FormatOf("-"))
+
+	out := &bytes.Buffer{}
+	assert.NoError(t, snapshot.Write(out, YAML))
+	assert.Contains(t, out.String(), "relationships: |-\n  license:o1/smarts#max@max:10\n  org:o1#member@user:u1\n")
+}
+
+func TestBootstrapRelationshipFilesAreReadAsSnapshots(t *testing.T) {
+	content := "relationships: |-\n  // OPERATION 1: Entitle an organization.\n  license:o1/smarts#max@max:10\n\n  org:o1#member@user:u1\n  org:o1#member@user:u1\n"
+
+	snapshot, err := Read(strings.NewReader(content), FormatOf("spicedb_bootstrap_relations.yaml"))
+	assert.NoError(t, err)
+	assert.Zero(t, snapshot.Version)
+
+	rels, err := snapshot.ParseRelationships()
+	assert.NoError(t, err)
+	assert.Len(t, rels, 2)
+
+	result, err := Import(context.Background(), newFakeStore(), snapshot)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Created)
+}
@@ -0,0 +1,48 @@
+package tracing
+
+import (
+	"authz/bootstrap/serviceconfig"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNoExporterIsCreatedIfTracingIsDisabled(t *testing.T) {
+	exporter, err := newExporter(serviceconfig.TracingConfig{Exporter: "none"})
+
+	assert.NoError(t, err)
+	assert.Nil(t, exporter)
+}
+
+func TestExporterIsCreatedForConfiguredKind(t *testing.T) {
+	for _, kind := range []string{"stdout", "otlp"} {
+		exporter, err := newExporter(serviceconfig.TracingConfig{Exporter: kind, Endpoint: "localhost:4317", Insecure: true})
+
+		assert.NoError(t, err, kind)
+		assert.NotNil(t, exporter, kind)
+		assert.NoError(t, exporter.Shutdown(context.Background()), kind)
+	}
+}
+
+func TestSpansContinueTheTraceOfIncomingRequests(t *testing.T) {
+	shutdown, err := Setup(serviceconfig.TracingConfig{Exporter: "stdout", SampleRatio: 0, ServiceName: "authz"})
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, shutdown(context.Background())) }()
+
+	header := http.Header{}
+	header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(header))
+
+	_, span := otel.Tracer("test").Start(ctx, "operation")
+	defer span.End()
+
+	// The caller decided to sample, which takes precedence over the sample ratio
+	assert.True(t, span.IsRecording())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", trace.SpanContextFromContext(ctx).SpanID().String())
+}
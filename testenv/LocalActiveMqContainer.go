@@ -192,6 +192,35 @@ func (l *LocalActiveMqContainer) SendSubjectUpdated(evt contracts.SubjectAddOrUp
 	return l.sender.Send(context.TODO(), msg, nil)
 }
 
+// SendSubjectDeleted sends a SubjectAddOrUpdateEvent representing a deleted subject to the local container
+func (l *LocalActiveMqContainer) SendSubjectDeleted(evt contracts.SubjectAddOrUpdateEvent) error {
+	msg := amqp.NewMessage(createSubjectDeletedEventData(evt.SubjectID, evt.OrgID))
+	return l.sender.Send(context.TODO(), msg, nil)
+}
+
+func createSubjectDeletedEventData(subjectID string, orgID string) []byte {
+	return []byte(fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+	<CanonicalMessage xmlns="http://esb.redhat.com/Canonical/6">
+	   <Header>
+		   <System>WEB</System>
+		   <Operation>delete</Operation>
+		   <Type>User</Type>
+		   <InstanceId>5e8657c988975300017006f2</InstanceId>
+		   <Timestamp>2020-04-02T17:23:21.412</Timestamp>
+	   </Header>
+	   <Payload>
+		   <Sync>
+			   <User>
+				   <Identifiers>
+					   <Identifier system="WEB" entity-name="User" qualifier="id">%s</Identifier>
+					   <Reference system="WEB" entity-name="Customer" qualifier="id">%s</Reference>
+				   </Identifiers>
+			   </User>
+		   </Sync>
+	   </Payload>
+	</CanonicalMessage>`, subjectID, orgID))
+}
+
 func createSubjectUpdatedEventData(subjectID string, orgID string, active bool) []byte {
 	return []byte(fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
 	<CanonicalMessage xmlns="http://esb.redhat.com/Canonical/6">